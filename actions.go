@@ -0,0 +1,95 @@
+package main
+
+import "fmt"
+
+const ShellAction = "Shell"
+
+// reservedActionNames are the titles of the entries every namespace has next to its actions
+var reservedActionNames = []string{"Set as current", workloadsTitle, podsTitle, portForwardTargetsTitle}
+
+// Action is a launch action offered in the submenu of every namespace
+type Action struct {
+	Name string `mapstructure:"name"`
+	// Command defaults to the shell command of the context
+	Command []string `mapstructure:"command"`
+	// Contexts restricts the action to context names or glob patterns
+	Contexts []string `mapstructure:"contexts"`
+}
+
+// GetActions returns the configured actions available for the context
+func GetActions(ctx string) []Action {
+	actions := GetConfig().Actions
+	if len(actions) == 0 {
+		actions = []Action{{Name: ShellAction}}
+	}
+	available := []Action{}
+	for _, action := range actions {
+		if action.Name != "" && action.AvailableFor(ctx) {
+			available = append(available, action)
+		}
+	}
+	return available
+}
+
+func (action Action) AvailableFor(ctx string) bool {
+	if len(action.Contexts) == 0 {
+		return true
+	}
+	for _, pattern := range action.Contexts {
+		if MatchContext(pattern, ctx) {
+			return true
+		}
+	}
+	return false
+}
+
+// SyncActions adds the entries of newly configured actions to the namespace and disposes the removed ones
+func (nsElement *Element) SyncActions(ctx string) {
+	configured := map[string]bool{}
+	for _, action := range GetActions(ctx) {
+		configured[action.Name] = true
+		if _, ok := nsElement.Children[action.Name]; ok {
+			continue
+		}
+		if _, ok := nsElement.Revive(action.Name); ok {
+			continue
+		}
+		actionElement := nsElement.AddChild(action.Name, true)
+		actionElement.Action = true
+		actionElement.ChannelWaitForAction(ctx, nsElement.Title, action.Name)
+	}
+	for title, childElement := range nsElement.Children {
+		if childElement.Action && !configured[title] {
+			childElement.Updated = false
+			nsElement.DisposeChildNonUpdated(title)
+		}
+	}
+}
+
+// UpdateActions syncs the actions of every namespace with the config
+func (rootElement *Element) UpdateActions() {
+	rootElement.mutex.Lock()
+	defer rootElement.mutex.Unlock()
+	for ctx, ctxElement := range rootElement.Children {
+		for _, nsElement := range ctxElement.Children {
+			if !nsElement.Locked {
+				nsElement.SyncActions(ctx)
+			}
+		}
+	}
+}
+
+// RunAction dispatches the action with the given name for the namespace
+func RunAction(name string, ctx string, ns string) error {
+	for _, action := range GetActions(ctx) {
+		if action.Name != name {
+			continue
+		}
+		if len(action.Command) == 0 {
+			return OpenTerminal(ctx, ns)
+		}
+		description := fmt.Sprintf("%s for %s | %s", name, ctx, ns)
+		return StartCommand(description, action.Command, NewTerminalData(ctx, ns), GetContextSettings(ctx))
+	}
+	return fmt.Errorf("action %s is not available for %s", name, ctx)
+}
@@ -0,0 +1,253 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/tabwriter"
+)
+
+const cliUsage = `Usage: kube-tray [command]
+
+Without command kube-tray runs in the system tray.
+
+Commands:
+  list              List contexts with their status and namespaces
+  shell <ctx> <ns>  Run the shell command of the namespace in this terminal
+  split             Split the kubeconfig per namespace and print the written kubeconfigs
+  path <ctx> <ns>   Print the kubeconfig of the namespace, e.g. export KUBECONFIG=$(kube-tray path <ctx> <ns>)
+  reload            Ask the running tray to reload the kubeconfig
+  open <ctx> <ns>   Ask the running tray to open the shell command of the namespace
+  config validate   Check ~/.kube-tray/config.yaml, printing the offending keys
+`
+
+// CLICommand is a headless subcommand taking a fixed number of arguments
+type CLICommand struct {
+	Args int
+	Run  func(stdout io.Writer, stderr io.Writer, args []string) error
+}
+
+var cliCommands = map[string]CLICommand{
+	"list":   {Args: 0, Run: runListCommand},
+	"shell":  {Args: 2, Run: runShellCommand},
+	"split":  {Args: 0, Run: runSplitCommand},
+	"path":   {Args: 2, Run: runPathCommand},
+	"reload": {Args: 0, Run: runReloadCommand},
+	"open":   {Args: 2, Run: runOpenCommand},
+	"config": {Args: 1, Run: runConfigCommand},
+}
+
+// RunCLI runs the subcommand without tray and returns the exit code, 2 for usage errors
+func RunCLI(args []string, stdout io.Writer, stderr io.Writer) int {
+	name := args[0]
+	if name == "help" || name == "-h" || name == "--help" {
+		fmt.Fprint(stdout, cliUsage)
+		return 0
+	}
+	command, ok := cliCommands[name]
+	if !ok {
+		fmt.Fprintf(stderr, "kube-tray: unknown command %q\n\n%s", name, cliUsage)
+		return 2
+	}
+	if len(args)-1 != command.Args {
+		fmt.Fprintf(stderr, "kube-tray %s: expected %d arguments, got %d\n\n%s", name, command.Args, len(args)-1, cliUsage)
+		return 2
+	}
+
+	LoadKubeconfig(false)
+	if err := command.Run(stdout, stderr, args[1:]); err != nil {
+		// Pass the exit code of the shell through
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			return exitErr.ExitCode()
+		}
+		fmt.Fprintf(stderr, "kube-tray %s: %s\n", name, err)
+		return 1
+	}
+	return 0
+}
+
+// FetchAllContexts fetches every context of the kubeconfig like a refresh of the tray, without writing anything
+func FetchAllContexts() map[string]ContextData {
+	refreshContext, cancel := context.WithTimeout(context.Background(), GetConfig().AutoRefresh.Deadline.Duration())
+	defer cancel()
+	results := map[string]ContextData{}
+	FetchContexts(refreshContext, GetKubeconfigContexts(), func(data ContextData) {
+		results[data.Context] = data
+	})
+	return results
+}
+
+func sortedContexts(results map[string]ContextData) []string {
+	ctxs := []string{}
+	for ctx := range results {
+		ctxs = append(ctxs, ctx)
+	}
+	sort.Strings(ctxs)
+	return ctxs
+}
+
+func runListCommand(stdout io.Writer, stderr io.Writer, args []string) error {
+	results := FetchAllContexts()
+	writer := tabwriter.NewWriter(stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(writer, "CURRENT\tCONTEXT\tSTATUS\tVERSION\tNAMESPACES")
+	invalid := GetInvalidContexts()
+	ctxs := sortedContexts(results)
+	for ctx := range invalid {
+		ctxs = append(ctxs, ctx)
+	}
+	sort.Strings(ctxs)
+	for _, ctx := range ctxs {
+		current := ""
+		if ctx == GetCurrentContextName() {
+			current = "*"
+		}
+		if reason, ok := invalid[ctx]; ok {
+			fmt.Fprintf(stderr, "%s: %s\n", ctx, reason)
+			fmt.Fprintf(writer, "%s\t%s\tinvalid\t\t\n", current, ctx)
+			continue
+		}
+		data := results[ctx]
+		status := string(data.Health.Status)
+		if status == "" {
+			status = "unknown"
+		}
+		// Failed contexts show the namespaces of their last successful refresh
+		namespaces := data.Namespaces
+		if data.Err != nil {
+			fmt.Fprintf(stderr, "%s: %s\n", ctx, data.Err)
+			namespaces = ReadContextNamespaces(ctx)
+		}
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\n", current, ctx, status, data.Health.Version, strings.Join(namespaces, ","))
+	}
+	return writer.Flush()
+}
+
+func runSplitCommand(stdout io.Writer, stderr io.Writer, args []string) error {
+	results := FetchAllContexts()
+	failed := 0
+	for _, ctx := range sortedContexts(results) {
+		data := results[ctx]
+		if data.Err != nil {
+			fmt.Fprintf(stderr, "%s: %s\n", ctx, data.Err)
+			failed++
+			continue
+		}
+		if !SaveContextData(data) {
+			fmt.Fprintf(stderr, "%s: changed while splitting, skipped\n", ctx)
+			failed++
+			continue
+		}
+		for _, ns := range data.Namespaces {
+			fmt.Fprintln(stdout, filepath.Join(contextDirectory, ctx, ns))
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d contexts failed, their previous kubeconfigs were kept", failed, len(results))
+	}
+	return nil
+}
+
+func runPathCommand(stdout io.Writer, stderr io.Writer, args []string) error {
+	path, err := getNamespaceKubeconfig(args[0], args[1])
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(stdout, path)
+	return nil
+}
+
+func runShellCommand(stdout io.Writer, stderr io.Writer, args []string) error {
+	ctx, ns := args[0], args[1]
+	if _, err := getNamespaceKubeconfig(ctx, ns); err != nil {
+		return err
+	}
+	settings := GetContextSettings(ctx)
+	cmd, err := NewCommand(GetShellCommand(settings), NewTerminalData(ctx, ns), settings)
+	if err != nil {
+		return err
+	}
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	return cmd.Run()
+}
+
+// errInvalidKubeconfigName rejects names that would not address a kubeconfig inside the contexts directory
+var errInvalidKubeconfigName = errors.New("invalid name")
+
+// getNamespaceKubeconfig returns the split kubeconfig of the namespace, explaining why it is missing, the names come
+// from the command line or the control API and the path is exported as KUBECONFIG, so they are checked first
+func getNamespaceKubeconfig(ctx string, ns string) (string, error) {
+	// Context names such as EKS ARNs contain slashes, each of their elements is checked instead
+	if err := checkKubeconfigName("context", ctx, strings.Split(ctx, "/")); err != nil {
+		return "", err
+	}
+	if err := checkKubeconfigName("namespace", ns, []string{ns}); err != nil {
+		return "", err
+	}
+	if _, ok := GetContextKubeconfig(ctx); !ok {
+		return "", fmt.Errorf("context %s not found in kubeconfig", ctx)
+	}
+	path := filepath.Join(contextDirectory, ctx, ns)
+	if info, err := os.Stat(path); err != nil || !info.Mode().IsRegular() {
+		return "", fmt.Errorf("no kubeconfig for namespace %s of %s, run kube-tray split first", ns, ctx)
+	}
+	return path, nil
+}
+
+// checkKubeconfigName rejects empty elements, elements starting with a dot like `..` and the metadata files, and
+// path separators
+func checkKubeconfigName(kind string, name string, elements []string) error {
+	for _, element := range elements {
+		if element == "" || strings.HasPrefix(element, ".") || strings.ContainsAny(element, `/\`) {
+			return fmt.Errorf("%w for %s %q", errInvalidKubeconfigName, kind, name)
+		}
+	}
+	return nil
+}
+
+func runReloadCommand(stdout io.Writer, stderr io.Writer, args []string) error {
+	return callRunningInstance(http.MethodPost, "/reload", ControlTarget{})
+}
+
+func runOpenCommand(stdout io.Writer, stderr io.Writer, args []string) error {
+	return callRunningInstance(http.MethodPost, "/terminal", ControlTarget{Context: args[0], Namespace: args[1]})
+}
+
+func runConfigCommand(stdout io.Writer, stderr io.Writer, args []string) error {
+	if args[0] != "validate" {
+		return fmt.Errorf("unknown config command %q, expected validate", args[0])
+	}
+	path := GetConfigPath()
+	loaded, err := ReadConfigFile()
+	var errs ConfigErrors
+	if errors.As(err, &errs) {
+		for _, err := range errs {
+			fmt.Fprintf(stderr, "%s: %s\n", path, err)
+		}
+		return fmt.Errorf("%d errors in %s", len(errs), path)
+	} else if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	for _, key := range loaded.UnknownKeys {
+		fmt.Fprintf(stderr, "%s: unknown key %s\n", path, key)
+	}
+	fmt.Fprintf(stdout, "%s is valid (version %d)\n", path, loaded.Version)
+	return nil
+}
+
+// callRunningInstance forwards the request to the tray holding the instance lock
+func callRunningInstance(method string, path string, request interface{}) error {
+	if _, ok := GetRunningInstance(); !ok {
+		return errors.New("kube-tray is not running")
+	}
+	return CallControl(method, path, request, nil)
+}
@@ -0,0 +1,240 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+func runTestCLI(args ...string) (int, string, string) {
+	var stdout, stderr bytes.Buffer
+	code := RunCLI(args, &stdout, &stderr)
+	return code, stdout.String(), stderr.String()
+}
+
+// setupCLIClusters serves kind-a and kind-b by fake clientsets, kind-b failing to list namespaces, and adds the
+// context broken referencing a missing cluster
+func setupCLIClusters(t *testing.T) {
+	t.Helper()
+	failing := newFakeClientset()
+	failing.PrependReactor("list", "namespaces", func(action k8stesting.Action) (bool, k8sruntime.Object, error) {
+		return true, nil, apierrors.NewInternalError(errors.New("etcd unavailable"))
+	})
+	setupTestClusters(t, map[string]kubernetes.Interface{
+		"kind-a": newFakeClientset("default", "team-a"),
+		"kind-b": failing,
+	})
+	config, err := clientcmd.LoadFromFile(os.Getenv("KUBECONFIG"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	config.Contexts["broken"] = &clientcmdapi.Context{Cluster: "missing", AuthInfo: "kind-a"}
+	writeTestKubeconfig(t, config)
+}
+
+func TestCLIUsageErrors(t *testing.T) {
+	setupTestHome(t)
+	code, _, stderr := runTestCLI("unknown")
+	if code != 2 || !strings.Contains(stderr, `unknown command "unknown"`) || !strings.Contains(stderr, "Usage:") {
+		t.Errorf("unknown command exited %d: %s", code, stderr)
+	}
+	code, _, stderr = runTestCLI("path", "kind-a")
+	if code != 2 || !strings.Contains(stderr, "expected 2 arguments, got 1") {
+		t.Errorf("missing argument exited %d: %s", code, stderr)
+	}
+	code, stdout, _ := runTestCLI("help")
+	if code != 0 || !strings.HasPrefix(stdout, "Usage:") {
+		t.Errorf("help exited %d: %s", code, stdout)
+	}
+}
+
+func TestCLIList(t *testing.T) {
+	setupCLIClusters(t)
+	code, stdout, stderr := runTestCLI("list")
+	if code != 0 {
+		t.Fatalf("list exited %d: %s", code, stderr)
+	}
+	lines := strings.Split(strings.TrimSpace(stdout), "\n")
+	if len(lines) != 4 {
+		t.Fatalf("list printed %d lines, want a header and 3 contexts:\n%s", len(lines), stdout)
+	}
+	for i, fields := range [][]string{
+		{"CURRENT", "CONTEXT", "STATUS", "VERSION", "NAMESPACES"},
+		{"broken", "invalid"},
+		{"*", "kind-a", "unknown", "default,team-a"},
+		{"kind-b", "unknown"},
+	} {
+		if got := strings.Fields(lines[i]); strings.Join(got, " ") != strings.Join(fields, " ") {
+			t.Errorf("line %d %q, want %q", i, got, fields)
+		}
+	}
+	if !strings.Contains(stderr, `broken: references missing cluster "missing"`) {
+		t.Errorf("stderr does not explain the invalid context: %s", stderr)
+	}
+	if !strings.Contains(stderr, "kind-b: Internal error occurred: etcd unavailable") {
+		t.Errorf("stderr does not explain the failed context: %s", stderr)
+	}
+}
+
+func TestCLISplitAndPath(t *testing.T) {
+	setupCLIClusters(t)
+	code, stdout, stderr := runTestCLI("split")
+	if code != 1 {
+		t.Errorf("split with a failing context exited %d", code)
+	}
+	if !strings.Contains(stderr, "kind-b: Internal error occurred: etcd unavailable") || !strings.Contains(stderr, "1 of 2 contexts failed") {
+		t.Errorf("stderr does not explain the failure: %s", stderr)
+	}
+	want := filepath.Join(contextDirectory, "kind-a", "team-a")
+	if !strings.Contains(stdout, want+"\n") {
+		t.Errorf("split did not print %s:\n%s", want, stdout)
+	}
+
+	code, stdout, _ = runTestCLI("path", "kind-a", "team-a")
+	if code != 0 || stdout != want+"\n" {
+		t.Errorf("path exited %d: %s", code, stdout)
+	}
+	code, _, stderr = runTestCLI("path", "kind-b", "default")
+	if code != 1 || !strings.Contains(stderr, "run kube-tray split first") {
+		t.Errorf("path of a failed context exited %d: %s", code, stderr)
+	}
+}
+
+// readContextFiles returns the content of every file in the contexts directory
+func readContextFiles(t *testing.T) map[string]string {
+	t.Helper()
+	files := map[string]string{}
+	err := filepath.Walk(contextDirectory, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		content, err := os.ReadFile(path)
+		files[path] = string(content)
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return files
+}
+
+// TestCLIListReadOnly lists namespaces created after the split without rewriting the kubeconfigs a tray may be using
+func TestCLIListReadOnly(t *testing.T) {
+	clientset := newFakeClientset("default")
+	setupTestClusters(t, map[string]kubernetes.Interface{"kind-a": clientset})
+	if code, _, stderr := runTestCLI("split"); code != 0 {
+		t.Fatalf("split exited %d: %s", code, stderr)
+	}
+	split := readContextFiles(t)
+	if len(split) != 1 {
+		t.Fatalf("split wrote %v", split)
+	}
+
+	namespace := &v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a"}}
+	if _, err := clientset.CoreV1().Namespaces().Create(context.Background(), namespace, metav1.CreateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	code, stdout, stderr := runTestCLI("list")
+	if code != 0 || !strings.Contains(stdout, "default,team-a") {
+		t.Errorf("list exited %d: %s%s", code, stdout, stderr)
+	}
+	if listed := readContextFiles(t); !reflect.DeepEqual(listed, split) {
+		t.Errorf("list changed the contexts directory from %v to %v", split, listed)
+	}
+}
+
+func TestCLIPathRejectsNames(t *testing.T) {
+	setupCLIClusters(t)
+	if code, _, stderr := runTestCLI("split"); code != 1 {
+		t.Fatalf("split exited %d: %s", code, stderr)
+	}
+	if err := os.MkdirAll(filepath.Join(contextDirectory, "kind-a", "directory"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(contextDirectory, "kind-a", namespaceSourceFile), []byte(NamespaceSourceProbe), 0644); err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range []struct {
+		ctx  string
+		ns   string
+		want string
+	}{
+		{ctx: "kind-a", ns: "", want: `invalid name for namespace ""`},
+		{ctx: "kind-a", ns: namespaceSourceFile, want: `invalid name for namespace ".namespace-source"`},
+		{ctx: "kind-a", ns: "../kind-b", want: `invalid name for namespace "../kind-b"`},
+		{ctx: "kind-a", ns: `..\kind-b`, want: "invalid name for namespace"},
+		{ctx: "../..", ns: "default", want: `invalid name for context "../.."`},
+		{ctx: "kind-a/../kind-b", ns: "default", want: `invalid name for context "kind-a/../kind-b"`},
+		{ctx: "", ns: "default", want: `invalid name for context ""`},
+		{ctx: "kind-a", ns: "directory", want: "no kubeconfig for namespace directory of kind-a"},
+	} {
+		code, stdout, stderr := runTestCLI("path", test.ctx, test.ns)
+		if code != 1 || stdout != "" || !strings.Contains(stderr, test.want) {
+			t.Errorf("path %q %q exited %d: %s%s", test.ctx, test.ns, code, stdout, stderr)
+		}
+	}
+}
+
+func TestCLIShellExitCode(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("needs sh")
+	}
+	setupCLIClusters(t)
+	if code, _, stderr := runTestCLI("split"); code != 1 {
+		t.Fatalf("split exited %d: %s", code, stderr)
+	}
+	setupTestConfig(t, func(config *Config) {
+		config.Shell.Command = []string{"sh", "-c", "echo $KUBECONFIG; echo failed >&2; exit 3"}
+	})
+	code, stdout, stderr := runTestCLI("shell", "kind-a", "default")
+	if code != 3 {
+		t.Errorf("shell exited %d, want the exit code of the shell", code)
+	}
+	if stdout != filepath.Join(contextDirectory, "kind-a", "default")+"\n" || stderr != "failed\n" {
+		t.Errorf("shell printed %q and %q", stdout, stderr)
+	}
+}
+
+func TestCLIConfigValidate(t *testing.T) {
+	home := setupTestHome(t)
+	writeTestKubeconfig(t, newTestKubeconfig())
+	path := filepath.Join(home, ".kube-tray", "config.yaml")
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte("version: 1\nlog-level: loud\ntypo: true\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	code, _, stderr := runTestCLI("config", "validate")
+	if code != 1 || !strings.Contains(stderr, `log-level: unknown level "loud"`) || !strings.Contains(stderr, "1 errors in") {
+		t.Errorf("invalid config exited %d: %s", code, stderr)
+	}
+
+	if err := os.WriteFile(path, []byte("version: 1\ntypo: true\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	code, stdout, stderr := runTestCLI("config", "validate")
+	if code != 0 || !strings.Contains(stdout, "is valid (version 1)") || !strings.Contains(stderr, "unknown key typo") {
+		t.Errorf("valid config exited %d: %s%s", code, stdout, stderr)
+	}
+
+	code, _, stderr = runTestCLI("config", "check")
+	if code != 1 || !strings.Contains(stderr, `unknown config command "check"`) {
+		t.Errorf("unknown config command exited %d: %s", code, stderr)
+	}
+}
@@ -0,0 +1,565 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mitchellh/mapstructure"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cast"
+	"gopkg.in/yaml.v3"
+)
+
+// configMigrations[i] migrates the settings of schema version i to version i+1
+var configMigrations = []func(settings map[string]interface{}){
+	// Version 0 is any config.yaml written before the schema was versioned
+	migrateCommandStrings,
+}
+
+// ConfigVersion is the schema version of config.yaml written by this kube-tray
+var ConfigVersion = len(configMigrations)
+
+// Seconds is a duration given in seconds in config.yaml
+type Seconds int
+
+func (seconds Seconds) Duration() time.Duration {
+	return time.Duration(seconds) * time.Second
+}
+
+// Config is the schema of config.yaml
+type Config struct {
+	Version      int                      `mapstructure:"version"`
+	LogLevel     string                   `mapstructure:"log-level"`
+	Shell        ShellConfig              `mapstructure:"shell"`
+	AutoRefresh  AutoRefreshConfig        `mapstructure:"auto-refresh"`
+	Problems     ProblemsConfig           `mapstructure:"problems"`
+	Workloads    WorkloadsConfig          `mapstructure:"workloads"`
+	Logs         LogsConfig               `mapstructure:"logs"`
+	Exec         ExecConfig               `mapstructure:"exec"`
+	Events       EventsConfig             `mapstructure:"events"`
+	StatusIcon   StatusIconConfig         `mapstructure:"status-icon"`
+	Contexts     map[string]ContextConfig `mapstructure:"contexts"`
+	Actions      []Action                 `mapstructure:"actions"`
+	PortForwards []PortForwardSpec        `mapstructure:"port-forwards"`
+}
+
+type ShellConfig struct {
+	Command []string `mapstructure:"command"`
+}
+
+type AutoRefreshConfig struct {
+	Enabled     bool    `mapstructure:"enabled"`
+	Interval    Seconds `mapstructure:"interval"`
+	Concurrency int     `mapstructure:"concurrency"`
+	// Timeout of a single request
+	Timeout Seconds `mapstructure:"timeout"`
+	// Deadline of a whole refresh
+	Deadline Seconds `mapstructure:"deadline"`
+}
+
+type ProblemsConfig struct {
+	PendingThreshold Seconds `mapstructure:"pending-threshold"`
+}
+
+type WorkloadsConfig struct {
+	ScaleReplicas []int `mapstructure:"scale-replicas"`
+}
+
+type LogsConfig struct {
+	TailLines int      `mapstructure:"tail-lines"`
+	Command   []string `mapstructure:"command"`
+}
+
+type ExecConfig struct {
+	Shells     []string `mapstructure:"shells"`
+	DebugImage string   `mapstructure:"debug-image"`
+	Terminal   []string `mapstructure:"terminal"`
+}
+
+type EventsConfig struct {
+	Contexts     []string `mapstructure:"contexts"`
+	Namespaces   []string `mapstructure:"namespaces"`
+	Reasons      []string `mapstructure:"reasons"`
+	Recent       int      `mapstructure:"recent"`
+	DedupeWindow Seconds  `mapstructure:"dedupe-window"`
+	RateLimit    int      `mapstructure:"rate-limit"`
+}
+
+type StatusIconConfig struct {
+	Contexts []string `mapstructure:"contexts"`
+	Exclude  []string `mapstructure:"exclude"`
+}
+
+// ContextConfig is an entry of `contexts`, merged with the other entries matching the context by GetContextSettings,
+// unset lists are nil and an unset protected is nil to let more specific entries override any value
+type ContextConfig struct {
+	Command    []string `mapstructure:"command"`
+	Env        []string `mapstructure:"env"`
+	Dir        string   `mapstructure:"dir"`
+	Namespaces []string `mapstructure:"namespaces"`
+	Protected  *bool    `mapstructure:"protected"`
+}
+
+// DefaultConfig returns the settings used for the keys missing from config.yaml
+func DefaultConfig() Config {
+	config := Config{
+		Version:  ConfigVersion,
+		LogLevel: "info",
+		AutoRefresh: AutoRefreshConfig{
+			Enabled:     false,
+			Interval:    3600,
+			Concurrency: 8,
+			Timeout:     10,
+			Deadline:    120,
+		},
+		Problems:  ProblemsConfig{PendingThreshold: 300},
+		Workloads: WorkloadsConfig{ScaleReplicas: []int{0, 1, 3}},
+		Logs:      LogsConfig{TailLines: 100},
+		Exec: ExecConfig{
+			Shells:     []string{"/bin/bash", "/bin/sh", "ash"},
+			DebugImage: "busybox",
+		},
+		Events: EventsConfig{
+			Recent:       10,
+			DedupeWindow: 600,
+			RateLimit:    5,
+		},
+	}
+	if runtime.GOOS == "windows" {
+		config.Shell.Command = []string{"cmd", "/c", "wt", "-w", "0", "nt"}
+		config.Logs.Command = []string{"cmd", "/c", "wt", "-w", "0", "nt", "powershell", "-NoExit", "Get-Content", "-Wait", "{{.File}}"}
+	} else {
+		// Untested: Darwin & Linux
+		config.Shell.Command = []string{"bash"}
+		config.Logs.Command = []string{"xterm", "-e", "tail", "-F", "{{.File}}"}
+		// The shell command starts a shell in the current terminal, pod commands need a new one
+		config.Exec.Terminal = []string{"xterm", "-e"}
+	}
+	return config
+}
+
+// DefaultConfigSettings returns the settings written to a new config.yaml, keys without default are left out
+func DefaultConfigSettings() (map[string]interface{}, error) {
+	settings := map[string]interface{}{}
+	if err := mapstructure.Decode(DefaultConfig(), &settings); err != nil {
+		return nil, err
+	}
+	pruneConfigSettings(settings)
+	return settings, nil
+}
+
+// pruneConfigSettings removes the nil values and the mappings left empty
+func pruneConfigSettings(settings map[string]interface{}) {
+	for key, value := range settings {
+		if nested, ok := value.(map[string]interface{}); ok {
+			pruneConfigSettings(nested)
+			if len(nested) == 0 {
+				delete(settings, key)
+			}
+			continue
+		}
+		reflected := reflect.ValueOf(value)
+		switch reflected.Kind() {
+		case reflect.Invalid:
+			delete(settings, key)
+		case reflect.Slice, reflect.Map, reflect.Ptr:
+			if reflected.IsNil() {
+				delete(settings, key)
+			}
+		}
+	}
+}
+
+// ConfigError points at the offending key of config.yaml
+type ConfigError struct {
+	Key     string
+	Message string
+}
+
+func (err ConfigError) Error() string {
+	if err.Key == "" {
+		return err.Message
+	}
+	return fmt.Sprintf("%s: %s", err.Key, err.Message)
+}
+
+// ConfigErrors lists every problem found in config.yaml
+type ConfigErrors []ConfigError
+
+func (errs ConfigErrors) Error() string {
+	messages := []string{}
+	for _, err := range errs {
+		messages = append(messages, err.Error())
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Validate checks the values of the config, errors are keyed like `actions[1].name`
+func (config Config) Validate() error {
+	errs := ConfigErrors{}
+	invalid := func(key string, format string, args ...interface{}) {
+		errs = append(errs, ConfigError{Key: key, Message: fmt.Sprintf(format, args...)})
+	}
+	positive := func(key string, value int) {
+		if value <= 0 {
+			invalid(key, "must be a positive number, got %d", value)
+		}
+	}
+	notNegative := func(key string, value int) {
+		if value < 0 {
+			invalid(key, "must not be negative, got %d", value)
+		}
+	}
+	patterns := func(key string, values []string) {
+		for i, pattern := range values {
+			if _, err := path.Match(pattern, ""); err != nil {
+				invalid(fmt.Sprintf("%s[%d]", key, i), "invalid pattern %q", pattern)
+			}
+		}
+	}
+
+	if _, err := log.ParseLevel(config.LogLevel); err != nil {
+		invalid("log-level", "unknown level %q", config.LogLevel)
+	}
+	if len(config.Shell.Command) == 0 {
+		invalid("shell.command", "must not be empty")
+	}
+	positive("auto-refresh.interval", int(config.AutoRefresh.Interval))
+	positive("auto-refresh.concurrency", config.AutoRefresh.Concurrency)
+	positive("auto-refresh.timeout", int(config.AutoRefresh.Timeout))
+	positive("auto-refresh.deadline", int(config.AutoRefresh.Deadline))
+	notNegative("problems.pending-threshold", int(config.Problems.PendingThreshold))
+	for i, replicas := range config.Workloads.ScaleReplicas {
+		notNegative(fmt.Sprintf("workloads.scale-replicas[%d]", i), replicas)
+	}
+	notNegative("logs.tail-lines", config.Logs.TailLines)
+	if len(config.Logs.Command) == 0 {
+		invalid("logs.command", "must not be empty")
+	}
+	if len(config.Exec.Shells) == 0 {
+		invalid("exec.shells", "must not be empty")
+	}
+	if config.Exec.DebugImage == "" {
+		invalid("exec.debug-image", "must not be empty")
+	}
+	patterns("events.contexts", config.Events.Contexts)
+	positive("events.recent", config.Events.Recent)
+	notNegative("events.dedupe-window", int(config.Events.DedupeWindow))
+	positive("events.rate-limit", config.Events.RateLimit)
+	patterns("status-icon.contexts", config.StatusIcon.Contexts)
+	patterns("status-icon.exclude", config.StatusIcon.Exclude)
+	for name := range config.Contexts {
+		if _, err := path.Match(name, ""); err != nil {
+			invalid(fmt.Sprintf("contexts.%s", name), "invalid pattern")
+		}
+	}
+	for i, action := range config.Actions {
+		key := fmt.Sprintf("actions[%d]", i)
+		if action.Name == "" {
+			invalid(key+".name", "must not be empty")
+		}
+		for _, reserved := range reservedActionNames {
+			if action.Name == reserved {
+				invalid(key+".name", "%q is an entry of every namespace already", action.Name)
+			}
+		}
+		patterns(key+".contexts", action.Contexts)
+	}
+	for i, spec := range config.PortForwards {
+		key := fmt.Sprintf("port-forwards[%d]", i)
+		if spec.Context == "" {
+			invalid(key+".context", "must not be empty")
+		}
+		if spec.Namespace == "" {
+			invalid(key+".namespace", "must not be empty")
+		}
+		if !strings.HasPrefix(spec.Target, "service/") && !strings.HasPrefix(spec.Target, "pod/") {
+			invalid(key+".target", "must be service/<name> or pod/<name>, got %q", spec.Target)
+		}
+		if spec.Port <= 0 || spec.Port > 65535 {
+			invalid(key+".port", "must be a port number, got %d", spec.Port)
+		}
+		if spec.LocalPort < 0 || spec.LocalPort > 65535 {
+			invalid(key+".local-port", "must be a port number, got %d", spec.LocalPort)
+		}
+	}
+
+	sort.SliceStable(errs, func(i, j int) bool {
+		return errs[i].Key < errs[j].Key
+	})
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// LoadedConfig is a parsed, migrated and validated config.yaml
+type LoadedConfig struct {
+	Config
+	// Settings as written in the file after migrations, without defaults
+	Settings map[string]interface{}
+	// Schema version of the file before migrations
+	FileVersion int
+	// Keys which are not part of the schema, usually typos
+	UnknownKeys []string
+}
+
+// ParseConfig parses config.yaml, migrates it to the current schema version and validates it, keys are kept as
+// written as context names may contain dots
+func ParseConfig(content []byte) (*LoadedConfig, error) {
+	settings := map[string]interface{}{}
+	if err := yaml.Unmarshal(content, &settings); err != nil {
+		return nil, err
+	}
+	if settings == nil {
+		// An empty file
+		settings = map[string]interface{}{}
+	}
+	version, err := cast.ToIntE(settings["version"])
+	if err != nil {
+		return nil, ConfigError{Key: "version", Message: fmt.Sprintf("must be a number, got %v", settings["version"])}
+	}
+	if version < 0 || version > ConfigVersion {
+		return nil, ConfigError{Key: "version", Message: fmt.Sprintf("unsupported version %d, this kube-tray supports up to %d", version, ConfigVersion)}
+	}
+	loaded := &LoadedConfig{Config: DefaultConfig(), Settings: settings, FileVersion: version}
+	for ; version < ConfigVersion; version++ {
+		configMigrations[version](loaded.Settings)
+	}
+	loaded.Settings["version"] = ConfigVersion
+
+	// Keys missing from the file keep their defaults, lists and maps given replace them
+	metadata := mapstructure.Metadata{}
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		DecodeHook:       mapstructure.StringToSliceHookFunc(","),
+		WeaklyTypedInput: true,
+		ZeroFields:       true,
+		Metadata:         &metadata,
+		Result:           &loaded.Config,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := decoder.Decode(loaded.Settings); err != nil {
+		// The decoder names the key in each of its errors
+		var decodeErr *mapstructure.Error
+		if errors.As(err, &decodeErr) {
+			errs := ConfigErrors{}
+			for _, message := range decodeErr.Errors {
+				errs = append(errs, ConfigError{Message: message})
+			}
+			return nil, errs
+		}
+		return nil, err
+	}
+	loaded.UnknownKeys = metadata.Unused
+	sort.Strings(loaded.UnknownKeys)
+	if err := loaded.Config.Validate(); err != nil {
+		return nil, err
+	}
+	return loaded, nil
+}
+
+// ReadConfigFile reads and parses config.yaml
+func ReadConfigFile() (*LoadedConfig, error) {
+	content, err := os.ReadFile(GetConfigPath())
+	if err != nil {
+		return nil, err
+	}
+	return ParseConfig(content)
+}
+
+// migrateCommandStrings turns commands given as a string into lists, the configs before the schema split them on spaces
+func migrateCommandStrings(settings map[string]interface{}) {
+	splitCommand := func(parent interface{}, key string) {
+		if settings, ok := parent.(map[string]interface{}); ok {
+			if command, ok := settings[key].(string); ok {
+				settings[key] = strings.Fields(command)
+			}
+		}
+	}
+	splitCommand(settings["shell"], "command")
+	splitCommand(settings["logs"], "command")
+	splitCommand(settings["exec"], "terminal")
+	if contexts, ok := settings["contexts"].(map[string]interface{}); ok {
+		for _, ctxSettings := range contexts {
+			splitCommand(ctxSettings, "command")
+			splitCommand(ctxSettings, "env")
+		}
+	}
+	if actions, ok := settings["actions"].([]interface{}); ok {
+		for _, action := range actions {
+			splitCommand(action, "command")
+		}
+	}
+}
+
+var (
+	configMutex   sync.RWMutex
+	currentConfig = DefaultConfig()
+	// Settings of config.yaml in use, without defaults
+	currentSettings = map[string]interface{}{}
+)
+
+// GetConfig returns the config in use, the defaults until config.yaml is loaded
+func GetConfig() Config {
+	configMutex.RLock()
+	defer configMutex.RUnlock()
+	return currentConfig
+}
+
+// GetConfigSettings returns the settings of config.yaml in use as written, without defaults
+func GetConfigSettings() map[string]interface{} {
+	configMutex.RLock()
+	defer configMutex.RUnlock()
+	return currentSettings
+}
+
+// InitConfig loads config.yaml, an invalid config.yaml is left untouched and the defaults are used. Only the owner,
+// the tray holding the instance lock, creates config.yaml with the defaults on first launch and saves migrations,
+// other launches use them in memory
+func InitConfig(owner bool) error {
+	path := GetConfigPath()
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		if !owner {
+			return nil
+		}
+		trayLog.Infof("Creating config [%s]", path)
+		defaults, err := DefaultConfigSettings()
+		if err != nil {
+			return err
+		}
+		if err := writeConfigSettings(defaults, ""); err != nil {
+			return err
+		}
+	}
+	loaded, err := ReadConfigFile()
+	if err != nil {
+		return fmt.Errorf("invalid %s, using the defaults: %w", path, err)
+	}
+	if !owner {
+		setConfig(loaded)
+		return nil
+	}
+	return UseConfig(loaded)
+}
+
+// UseConfig makes the loaded config the one in use, rewriting config.yaml when it was migrated
+func UseConfig(loaded *LoadedConfig) error {
+	for _, key := range loaded.UnknownKeys {
+		trayLog.Warningf("Unknown key %s in config", key)
+	}
+	if loaded.FileVersion < ConfigVersion {
+		trayLog.Infof("Migrating config from version %d to %d", loaded.FileVersion, ConfigVersion)
+		if err := writeConfigSettings(loaded.Settings, fmt.Sprintf(".v%d.bak", loaded.FileVersion)); err != nil {
+			trayLog.Warningf("Unable to save the migrated config: %s", err)
+		}
+	}
+	setConfig(loaded)
+	return nil
+}
+
+func setConfig(loaded *LoadedConfig) {
+	configMutex.Lock()
+	currentConfig = loaded.Config
+	currentSettings = loaded.Settings
+	configMutex.Unlock()
+}
+
+// SaveConfigValue sets a dotted key of the schema in config.yaml, the rest of the file including its comments is
+// kept, an invalid config.yaml is not overwritten
+func SaveConfigValue(key string, value interface{}) error {
+	path := GetConfigPath()
+	content, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if _, err := ParseConfig(content); err != nil {
+		return fmt.Errorf("not saving %s to invalid config: %w", key, err)
+	}
+	var document yaml.Node
+	if err := yaml.Unmarshal(content, &document); err != nil {
+		return err
+	}
+	if document.Kind == 0 {
+		document = yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{{Kind: yaml.MappingNode, Tag: "!!map"}}}
+	}
+	if err := setConfigNode(document.Content[0], strings.Split(key, "."), value); err != nil {
+		return fmt.Errorf("unable to save %s: %w", key, err)
+	}
+	return writeConfigSettings(&document, ".bak")
+}
+
+// setConfigNode sets the value under the keys of the mapping, adding the missing mappings
+func setConfigNode(mapping *yaml.Node, keys []string, value interface{}) error {
+	if mapping.Kind != yaml.MappingNode {
+		return fmt.Errorf("%s is not a mapping", strings.Join(keys, "."))
+	}
+	var child *yaml.Node
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == keys[0] {
+			child = mapping.Content[i+1]
+			break
+		}
+	}
+	if child == nil {
+		child = &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+		mapping.Content = append(mapping.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: keys[0]}, child)
+	}
+	if len(keys) > 1 {
+		return setConfigNode(child, keys[1:], value)
+	}
+	// Keep the comments of the replaced value
+	var encoded yaml.Node
+	if err := encoded.Encode(value); err != nil {
+		return err
+	}
+	encoded.HeadComment, encoded.LineComment, encoded.FootComment = child.HeadComment, child.LineComment, child.FootComment
+	*child = encoded
+	return nil
+}
+
+// BackupConfig copies config.yaml next to it with the suffix
+func BackupConfig(suffix string) error {
+	path := GetConfigPath()
+	content, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path+suffix, content, 0600)
+}
+
+// writeConfigSettings rewrites config.yaml from settings or an edited yaml.Node, backing up the previous file
+// unless the suffix is empty
+func writeConfigSettings(settings interface{}, backupSuffix string) error {
+	if backupSuffix != "" {
+		if err := BackupConfig(backupSuffix); err != nil {
+			return fmt.Errorf("unable to back up config: %w", err)
+		}
+	}
+	var content bytes.Buffer
+	encoder := yaml.NewEncoder(&content)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(settings); err != nil {
+		return err
+	}
+	if err := encoder.Close(); err != nil {
+		return err
+	}
+	path := GetConfigPath()
+	os.MkdirAll(filepath.Dir(path), 0755)
+	return os.WriteFile(path, content.Bytes(), 0644)
+}
@@ -0,0 +1,302 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestExecTerminalDefault(t *testing.T) {
+	loaded, err := ParseConfig([]byte("version: 1\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	terminal := loaded.Exec.Terminal
+	if runtime.GOOS == "windows" {
+		// The shell command of Windows opens a new tab of Windows Terminal
+		if len(terminal) != 0 {
+			t.Errorf("exec.terminal %v, want empty", terminal)
+		}
+		return
+	}
+	if want := []string{"xterm", "-e"}; !reflect.DeepEqual(terminal, want) {
+		t.Errorf("exec.terminal %v, want %v", terminal, want)
+	}
+
+	loaded, err = ParseConfig([]byte("version: 1\nexec:\n  terminal: [\"gnome-terminal\", \"--\"]\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"gnome-terminal", "--"}; !reflect.DeepEqual(loaded.Exec.Terminal, want) {
+		t.Errorf("exec.terminal %v, want %v", loaded.Exec.Terminal, want)
+	}
+}
+
+func TestValidateReservedActionNames(t *testing.T) {
+	_, err := ParseConfig([]byte("version: 1\nactions:\n  - name: Shell\n  - name: Pods\n  - name: Workloads\n"))
+	errs, ok := err.(ConfigErrors)
+	if !ok {
+		t.Fatalf("expected config errors, got %v", err)
+	}
+	keys := []string{}
+	for _, configErr := range errs {
+		keys = append(keys, configErr.Key)
+	}
+	if want := []string{"actions[1].name", "actions[2].name"}; !reflect.DeepEqual(keys, want) {
+		t.Errorf("errors for %v, want %v", keys, want)
+	}
+}
+
+func readConfigFixture(t *testing.T, name string) []byte {
+	t.Helper()
+	content, err := os.ReadFile(filepath.Join("testdata", "config", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return content
+}
+
+// useConfigFixture copies the fixture to config.yaml of a temporary home and loads it
+func useConfigFixture(t *testing.T, name string) string {
+	t.Helper()
+	setupTestHome(t)
+	path := GetConfigPath()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, readConfigFixture(t, name), 0644); err != nil {
+		t.Fatal(err)
+	}
+	configMutex.RLock()
+	previousConfig, previousSettings := currentConfig, currentSettings
+	configMutex.RUnlock()
+	t.Cleanup(func() {
+		configMutex.Lock()
+		currentConfig, currentSettings = previousConfig, previousSettings
+		configMutex.Unlock()
+	})
+	return path
+}
+
+func TestParseConfigFixtures(t *testing.T) {
+	t.Run("dotted context names", func(t *testing.T) {
+		loaded, err := ParseConfig(readConfigFixture(t, "dotted.yaml"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		names := []string{}
+		for name := range loaded.Contexts {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		if want := []string{"*.example.com", "Dev-Cluster", "prod.example.com"}; !reflect.DeepEqual(names, want) {
+			t.Errorf("contexts %v, want %v", names, want)
+		}
+		prod := loaded.Contexts["prod.example.com"]
+		if prod.Protected == nil || !*prod.Protected || !reflect.DeepEqual(prod.Namespaces, []string{"payments", "orders"}) {
+			t.Errorf("prod.example.com decoded as %+v", prod)
+		}
+		if loaded.AutoRefresh.Interval != 600 || loaded.AutoRefresh.Concurrency != DefaultConfig().AutoRefresh.Concurrency {
+			t.Errorf("auto-refresh decoded as %+v", loaded.AutoRefresh)
+		}
+		if len(loaded.UnknownKeys) != 0 {
+			t.Errorf("unknown keys %v", loaded.UnknownKeys)
+		}
+	})
+
+	t.Run("unversioned", func(t *testing.T) {
+		loaded, err := ParseConfig(readConfigFixture(t, "unversioned.yaml"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if loaded.FileVersion != 0 || loaded.Version != ConfigVersion {
+			t.Errorf("version %d migrated to %d", loaded.FileVersion, loaded.Version)
+		}
+		if want := []string{"cmd", "/c", "wt", "-w", "0", "nt"}; !reflect.DeepEqual(loaded.Shell.Command, want) {
+			t.Errorf("shell.command %v, want %v", loaded.Shell.Command, want)
+		}
+		if want := []string{"bash", "-l"}; !reflect.DeepEqual(loaded.Contexts["kind.local"].Command, want) {
+			t.Errorf("contexts.kind.local.command %v, want %v", loaded.Contexts["kind.local"].Command, want)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := ParseConfig(readConfigFixture(t, "invalid.yaml"))
+		errs, ok := err.(ConfigErrors)
+		if !ok {
+			t.Fatalf("expected config errors, got %v", err)
+		}
+		keys := []string{}
+		for _, configErr := range errs {
+			keys = append(keys, configErr.Key)
+		}
+		if want := []string{"actions[0].name", "auto-refresh.interval", "log-level"}; !reflect.DeepEqual(keys, want) {
+			t.Errorf("errors for %v, want %v", keys, want)
+		}
+	})
+}
+
+func TestContextSettingsWithDots(t *testing.T) {
+	useConfigFixture(t, "dotted.yaml")
+	if err := InitConfig(true); err != nil {
+		t.Fatal(err)
+	}
+	settings := GetContextSettings("prod.example.com")
+	if !IsProtectedContext("prod.example.com") {
+		t.Errorf("prod.example.com not protected: %+v", settings)
+	}
+	if want := []string{"payments", "orders"}; !reflect.DeepEqual(GetContextNamespaces("prod.example.com"), want) {
+		t.Errorf("namespaces %v, want %v", GetContextNamespaces("prod.example.com"), want)
+	}
+	if !reflect.DeepEqual(settings.Env, []string{"AWS_PROFILE=prod"}) {
+		t.Errorf("env of the pattern %v", settings.Env)
+	}
+	if IsProtectedContext("staging.example.com") {
+		t.Error("staging.example.com protected by the pattern")
+	}
+	if command := GetShellCommand(GetContextSettings("dev-cluster")); !reflect.DeepEqual(command, []string{"bash", "-l"}) {
+		t.Errorf("command of dev-cluster %v", command)
+	}
+}
+
+func TestSaveConfigValueKeepsFile(t *testing.T) {
+	path := useConfigFixture(t, "dotted.yaml")
+	original := string(readConfigFixture(t, "dotted.yaml"))
+
+	if err := SaveConfigValue("auto-refresh.enabled", true); err != nil {
+		t.Fatal(err)
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := strings.Replace(original, "enabled: false", "enabled: true", 1)
+	if string(content) != want {
+		t.Errorf("config.yaml saved as\n%s\nwant\n%s", content, want)
+	}
+	if backup, err := os.ReadFile(path + ".bak"); err != nil || string(backup) != original {
+		t.Errorf("backup %q, %v", backup, err)
+	}
+
+	if err := SaveConfigValue("events.rate-limit", 3); err != nil {
+		t.Fatal(err)
+	}
+	content, err = os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := ParseConfig(content)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !loaded.AutoRefresh.Enabled || loaded.Events.RateLimit != 3 || !reflect.DeepEqual(loaded.Contexts["prod.example.com"].Namespaces, []string{"payments", "orders"}) {
+		t.Errorf("saved config decoded as %+v", loaded.Config)
+	}
+}
+
+func TestSaveConfigValueRejectsInvalidFile(t *testing.T) {
+	path := useConfigFixture(t, "invalid.yaml")
+	if err := SaveConfigValue("auto-refresh.enabled", true); err == nil {
+		t.Error("expected an error saving to an invalid config")
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(content) != string(readConfigFixture(t, "invalid.yaml")) {
+		t.Errorf("invalid config overwritten:\n%s", content)
+	}
+}
+
+// TestInitConfigReadOnly loads config.yaml like a launch not holding the instance lock, the file of the running tray
+// is neither created nor migrated
+func TestInitConfigReadOnly(t *testing.T) {
+	path := useConfigFixture(t, "unversioned.yaml")
+	if err := InitConfig(false); err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"bash", "-l"}; !reflect.DeepEqual(GetShellCommand(GetContextSettings("kind.local")), want) {
+		t.Errorf("migrated command of kind.local %v, want %v", GetShellCommand(GetContextSettings("kind.local")), want)
+	}
+	if content, err := os.ReadFile(path); err != nil || string(content) != string(readConfigFixture(t, "unversioned.yaml")) {
+		t.Errorf("config.yaml rewritten: %s, %v", content, err)
+	}
+	if _, err := os.Stat(path + ".v0.bak"); !os.IsNotExist(err) {
+		t.Errorf("migration backed up: %v", err)
+	}
+
+	if err := os.Remove(path); err != nil {
+		t.Fatal(err)
+	}
+	if err := InitConfig(false); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("config.yaml created: %v", err)
+	}
+
+	if err := InitConfig(true); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(path); err != nil {
+		t.Errorf("config.yaml not created by the owner: %v", err)
+	}
+}
+
+// TestDefaultConfigFile creates config.yaml on first launch, it parses back to the defaults
+func TestDefaultConfigFile(t *testing.T) {
+	useConfigFixture(t, "dotted.yaml")
+	if err := os.Remove(GetConfigPath()); err != nil {
+		t.Fatal(err)
+	}
+	if err := InitConfig(true); err != nil {
+		t.Fatal(err)
+	}
+	content, err := os.ReadFile(GetConfigPath())
+	if err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := ParseConfig(content)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(loaded.Config, DefaultConfig()) || loaded.FileVersion != ConfigVersion || len(loaded.UnknownKeys) != 0 {
+		t.Errorf("config.yaml created as\n%s", content)
+	}
+	for _, key := range []string{"contexts:", "actions:", "null"} {
+		if strings.Contains(string(content), key) {
+			t.Errorf("config.yaml created with %s:\n%s", key, content)
+		}
+	}
+}
+
+func TestContextSettingsOverride(t *testing.T) {
+	protected, unprotected := true, false
+	setupTestConfig(t, func(config *Config) {
+		config.Contexts = map[string]ContextConfig{
+			"kind-*":    {Protected: &protected, Namespaces: []string{"team-a"}, Dir: "/work", Env: []string{"A=1"}},
+			"kind-kind": {Protected: &unprotected, Namespaces: []string{}},
+		}
+	})
+	settings := GetContextSettings("Kind-Kind")
+	if IsProtectedContext("kind-kind") || settings.Namespaces == nil || len(settings.Namespaces) != 0 {
+		t.Errorf("exact entry did not override the pattern: %+v", settings)
+	}
+	if settings.Dir != "/work" || !reflect.DeepEqual(settings.Env, []string{"A=1"}) {
+		t.Errorf("settings unset by the exact entry not taken from the pattern: %+v", settings)
+	}
+	if !IsProtectedContext("kind-other") || !reflect.DeepEqual(GetContextNamespaces("kind-other"), []string{"team-a"}) {
+		t.Errorf("pattern not applied: %+v", GetContextSettings("kind-other"))
+	}
+}
+
+func TestParseConfigContextTypes(t *testing.T) {
+	_, err := ParseConfig([]byte("version: 1\ncontexts:\n  prod.example.com:\n    protected: \"yes\"\n"))
+	if err == nil || !strings.Contains(err.Error(), "contexts[prod.example.com].protected") {
+		t.Errorf("expected an error for the protected value, got %v", err)
+	}
+}
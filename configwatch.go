@@ -0,0 +1,146 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/client-go/util/homedir"
+)
+
+const configWatchDebounce = time.Second
+
+// Signals the tray loop to reset the auto refresh ticker
+var configReloadedCh = make(chan struct{}, 1)
+
+func GetConfigPath() string {
+	return filepath.Join(homedir.HomeDir(), ".kube-tray", "config.yaml")
+}
+
+// WatchConfig re-applies config.yaml when it changes on disk
+func WatchConfig() {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		trayLog.Warning(err)
+		return
+	}
+	path := GetConfigPath()
+	// Watch the directory as editors usually replace the file instead of writing it
+	if err := watcher.Add(filepath.Dir(path)); err != nil {
+		trayLog.Warningf("Unable to watch %s: %s", path, err)
+		return
+	}
+	trayLog.Infof("Watching config [%s]", path)
+
+	go func() {
+		var debounce *time.Timer
+		for {
+			select {
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				if filepath.Clean(event.Name) != path {
+					continue
+				}
+				if debounce != nil {
+					debounce.Stop()
+				}
+				debounce = time.AfterFunc(configWatchDebounce, ReloadConfig)
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				trayLog.Warning(err)
+			}
+		}
+	}()
+}
+
+// ReloadConfig validates config.yaml and applies it, an invalid config is rejected and the last good one is kept
+func ReloadConfig() {
+	loaded, err := ReadConfigFile()
+	if err != nil {
+		ShowLastError(fmt.Errorf("invalid config.yaml, keeping the last good one: %w", err))
+		return
+	}
+
+	before := flattenSettings("", GetConfigSettings())
+	if err := UseConfig(loaded); err != nil {
+		ShowLastError(err)
+		return
+	}
+	changes := DiffSettings(before, flattenSettings("", GetConfigSettings()))
+	if len(changes) == 0 {
+		return
+	}
+	trayLog.Infof("Config changed: %s", strings.Join(changes, "; "))
+	ApplyConfig()
+}
+
+// ApplyConfig re-applies the settings which are only read at startup
+func ApplyConfig() {
+	ApplyLogLevel()
+	config := GetConfig()
+	setAutoRefreshState(config.AutoRefresh.Enabled)
+	select {
+	case configReloadedCh <- struct{}{}:
+	default:
+	}
+	if throttled, ok := notifier.(*ThrottledNotifier); ok {
+		throttled.SetLimits(config.Events.DedupeWindow.Duration(), config.Events.RateLimit)
+	}
+	UpdateEventWatches()
+	UpdateSavedPortForwards()
+	rootElement.UpdateActions()
+	UpdateTrayStatus()
+}
+
+func ApplyLogLevel() {
+	level, err := log.ParseLevel(GetConfig().LogLevel)
+	if err != nil {
+		trayLog.Warning(err)
+		return
+	}
+	log.SetLevel(level)
+}
+
+// flattenSettings turns nested settings into dotted keys
+func flattenSettings(prefix string, settings map[string]interface{}) map[string]interface{} {
+	flat := map[string]interface{}{}
+	for key, value := range settings {
+		if nested, ok := value.(map[string]interface{}); ok {
+			for nestedKey, nestedValue := range flattenSettings(prefix+key+".", nested) {
+				flat[nestedKey] = nestedValue
+			}
+			continue
+		}
+		flat[prefix+key] = value
+	}
+	return flat
+}
+
+// DiffSettings describes the added, removed and changed keys, sorted by key
+func DiffSettings(before map[string]interface{}, after map[string]interface{}) []string {
+	changes := []string{}
+	for key, value := range after {
+		previous, ok := before[key]
+		if !ok {
+			changes = append(changes, fmt.Sprintf("%s: added %v", key, value))
+		} else if !reflect.DeepEqual(previous, value) {
+			changes = append(changes, fmt.Sprintf("%s: %v -> %v", key, previous, value))
+		}
+	}
+	for key, value := range before {
+		if _, ok := after[key]; !ok {
+			changes = append(changes, fmt.Sprintf("%s: removed %v", key, value))
+		}
+	}
+	sort.Strings(changes)
+	return changes
+}
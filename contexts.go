@@ -0,0 +1,73 @@
+package main
+
+import (
+	"path"
+	"sort"
+	"strings"
+)
+
+// GetContextSettings merges the `contexts` entries of config.yaml matching the given context,
+// keys are context names or glob patterns, more specific patterns and exact names take precedence
+func GetContextSettings(ctx string) ContextConfig {
+	ctx = strings.ToLower(ctx)
+	names := []string{}
+	contexts := GetConfig().Contexts
+	for name := range contexts {
+		if MatchContext(name, ctx) {
+			names = append(names, name)
+		}
+	}
+	exact := func(name string) bool {
+		return strings.ToLower(name) == ctx
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if exact(names[i]) != exact(names[j]) {
+			return exact(names[j])
+		}
+		if len(names[i]) != len(names[j]) {
+			return len(names[i]) < len(names[j])
+		}
+		return names[i] < names[j]
+	})
+
+	settings := ContextConfig{}
+	for _, name := range names {
+		settings.merge(contexts[name])
+	}
+	return settings
+}
+
+// merge overrides the settings with those set by the entry
+func (settings *ContextConfig) merge(entry ContextConfig) {
+	if entry.Command != nil {
+		settings.Command = entry.Command
+	}
+	if entry.Env != nil {
+		settings.Env = entry.Env
+	}
+	if entry.Dir != "" {
+		settings.Dir = entry.Dir
+	}
+	if entry.Namespaces != nil {
+		settings.Namespaces = entry.Namespaces
+	}
+	if entry.Protected != nil {
+		settings.Protected = entry.Protected
+	}
+}
+
+// MatchContext matches the context against a name or glob pattern, case insensitive
+func MatchContext(pattern string, ctx string) bool {
+	pattern = strings.ToLower(pattern)
+	ctx = strings.ToLower(ctx)
+	if pattern == ctx {
+		return true
+	}
+	// Let wildcards span the slashes of names such as EKS ARNs
+	matched, _ := path.Match(strings.ReplaceAll(pattern, "/", ":"), strings.ReplaceAll(ctx, "/", ":"))
+	return matched
+}
+
+func GetContextNamespaces(ctx string) []string {
+	return GetContextSettings(ctx).Namespaces
+}
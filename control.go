@@ -0,0 +1,249 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+const controlTimeout = 5 * time.Second
+
+// ContextStatus is the cached state of a context served by the control API
+type ContextStatus struct {
+	Name            string            `json:"name"`
+	Current         bool              `json:"current"`
+	Status          HealthStatus      `json:"status"`
+	Version         string            `json:"version,omitempty"`
+	Message         string            `json:"message,omitempty"`
+	NamespaceSource string            `json:"namespaceSource,omitempty"`
+	Namespaces      []NamespaceStatus `json:"namespaces"`
+}
+
+// NamespaceStatus is a namespace of a context with its split kubeconfig and failing pods
+type NamespaceStatus struct {
+	Name       string       `json:"name"`
+	Kubeconfig string       `json:"kubeconfig"`
+	Problems   []PodProblem `json:"problems,omitempty"`
+}
+
+// ControlTarget selects a context, and a namespace where needed, in control requests
+type ControlTarget struct {
+	Context   string `json:"context"`
+	Namespace string `json:"namespace"`
+}
+
+// AutoRefreshState toggles auto refresh when Enabled is omitted
+type AutoRefreshState struct {
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+type controlError struct {
+	Error string `json:"error"`
+}
+
+var controlListener net.Listener
+
+// GetContextStatuses returns the state of every context from the caches of the last refreshes, without API calls
+func GetContextStatuses() []ContextStatus {
+	statuses := []ContextStatus{}
+	ctxs := GetKubeconfigContexts()
+	sort.Strings(ctxs)
+	for _, ctx := range ctxs {
+		health := GetContextHealth(ctx)
+		problems := GetContextProblems(ctx)
+		status := ContextStatus{
+			Name:            ctx,
+			Current:         ctx == GetCurrentContextName(),
+			Status:          health.Status,
+			Version:         health.Version,
+			Message:         health.Message,
+			NamespaceSource: ReadNamespaceSource(ctx),
+			Namespaces:      []NamespaceStatus{},
+		}
+		for _, ns := range ReadContextNamespaces(ctx) {
+			status.Namespaces = append(status.Namespaces, NamespaceStatus{
+				Name:       ns,
+				Kubeconfig: filepath.Join(contextDirectory, ctx, ns),
+				Problems:   problems[ns],
+			})
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
+
+// StartControlServer serves the control API on the socket, or named pipe on Windows, of GetControlAddress
+func StartControlServer() {
+	address := GetControlAddress()
+	listener, err := ListenControl(address)
+	if err != nil {
+		trayLog.Warningf("Control API unavailable: %s", err)
+		return
+	}
+	controlListener = listener
+	trayLog.Infof("Control API listening on %s", address)
+	go func() {
+		err := http.Serve(listener, NewControlHandler())
+		if err != nil && !errors.Is(err, net.ErrClosed) {
+			trayLog.Warningf("Control API stopped: %s", err)
+		}
+	}()
+}
+
+func StopControlServer() {
+	if controlListener != nil {
+		controlListener.Close()
+	}
+}
+
+// NewControlHandler routes the control API, access is restricted by the permissions of the socket
+func NewControlHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/contexts", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			writeControlError(w, http.StatusMethodNotAllowed, fmt.Errorf("%s not allowed", r.Method))
+			return
+		}
+		writeControlResponse(w, http.StatusOK, GetContextStatuses())
+	})
+	mux.HandleFunc("/refresh", func(w http.ResponseWriter, r *http.Request) {
+		var target ControlTarget
+		if !readControlRequest(w, r, &target) {
+			return
+		}
+		if target.Context == "" {
+			trayLog.Info("Control API: refresh all contexts")
+			go rootElement.UpdateData()
+		} else {
+			if _, ok := GetContextKubeconfig(target.Context); !ok {
+				writeControlError(w, http.StatusNotFound, fmt.Errorf("context %s not found", target.Context))
+				return
+			}
+			trayLog.Infof("Control API: refresh %s", target.Context)
+			go rootElement.UpdateContextData(target.Context)
+		}
+		writeControlResponse(w, http.StatusAccepted, target)
+	})
+	mux.HandleFunc("/reload", func(w http.ResponseWriter, r *http.Request) {
+		var target ControlTarget
+		if !readControlRequest(w, r, &target) {
+			return
+		}
+		trayLog.Info("Control API: reload kubeconfig")
+		ReloadKubeconfig()
+		writeControlResponse(w, http.StatusAccepted, target)
+	})
+	mux.HandleFunc("/terminal", func(w http.ResponseWriter, r *http.Request) {
+		var target ControlTarget
+		if !readControlRequest(w, r, &target) {
+			return
+		}
+		if _, err := getNamespaceKubeconfig(target.Context, target.Namespace); errors.Is(err, errInvalidKubeconfigName) {
+			writeControlError(w, http.StatusBadRequest, err)
+			return
+		} else if err != nil {
+			writeControlError(w, http.StatusNotFound, err)
+			return
+		}
+		trayLog.Infof("Control API: open terminal for %s | %s", target.Context, target.Namespace)
+		if err := OpenTerminal(target.Context, target.Namespace); err != nil {
+			writeControlError(w, http.StatusInternalServerError, err)
+			return
+		}
+		writeControlResponse(w, http.StatusOK, target)
+	})
+	mux.HandleFunc("/auto-refresh", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			enabled := IsAutoRefresh()
+			writeControlResponse(w, http.StatusOK, AutoRefreshState{Enabled: &enabled})
+			return
+		}
+		var state AutoRefreshState
+		if !readControlRequest(w, r, &state) {
+			return
+		}
+		enabled := !IsAutoRefresh()
+		if state.Enabled != nil {
+			enabled = *state.Enabled
+		}
+		SetAutoRefresh(enabled)
+		writeControlResponse(w, http.StatusOK, AutoRefreshState{Enabled: &enabled})
+	})
+	return mux
+}
+
+// readControlRequest decodes the body of a POST request, an empty body is the zero request
+func readControlRequest(w http.ResponseWriter, r *http.Request, request interface{}) bool {
+	if r.Method != http.MethodPost {
+		writeControlError(w, http.StatusMethodNotAllowed, fmt.Errorf("%s not allowed", r.Method))
+		return false
+	}
+	err := json.NewDecoder(r.Body).Decode(request)
+	if err != nil && err != io.EOF {
+		writeControlError(w, http.StatusBadRequest, fmt.Errorf("invalid request: %w", err))
+		return false
+	}
+	return true
+}
+
+func writeControlResponse(w http.ResponseWriter, statusCode int, response interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		trayLog.Warningf("Control API: %s", err)
+	}
+}
+
+func writeControlError(w http.ResponseWriter, statusCode int, err error) {
+	writeControlResponse(w, statusCode, controlError{Error: err.Error()})
+}
+
+// CallControl sends a request to the control API of the running instance and decodes its response
+func CallControl(method string, path string, request interface{}, response interface{}) error {
+	address := GetControlAddress()
+	client := &http.Client{
+		Timeout: controlTimeout,
+		Transport: &http.Transport{
+			DialContext: func(reqContext context.Context, _ string, _ string) (net.Conn, error) {
+				return DialControl(reqContext, address)
+			},
+		},
+	}
+	var body io.Reader
+	if request != nil {
+		encoded, err := json.Marshal(request)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(encoded)
+	}
+	// The host is ignored, connections always go to the control address
+	httpRequest, err := http.NewRequest(method, "http://kube-tray"+path, body)
+	if err != nil {
+		return err
+	}
+	httpResponse, err := client.Do(httpRequest)
+	if err != nil {
+		return err
+	}
+	defer httpResponse.Body.Close()
+	if httpResponse.StatusCode >= http.StatusBadRequest {
+		var failure controlError
+		if err := json.NewDecoder(httpResponse.Body).Decode(&failure); err != nil || failure.Error == "" {
+			return fmt.Errorf("control API responded %s", httpResponse.Status)
+		}
+		return errors.New(failure.Error)
+	}
+	if response == nil {
+		return nil
+	}
+	return json.NewDecoder(httpResponse.Body).Decode(response)
+}
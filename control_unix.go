@@ -0,0 +1,53 @@
+//go:build linux || darwin
+// +build linux darwin
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+
+	"k8s.io/client-go/util/homedir"
+)
+
+const controlSocketName = "control.sock"
+
+// GetControlAddress returns the socket in a directory only accessible to the current user
+func GetControlAddress() string {
+	return filepath.Join(homedir.HomeDir(), ".kube-tray", "run", controlSocketName)
+}
+
+// ListenControl creates the control socket accessible to the current user only, replacing a socket left by a crash
+func ListenControl(address string) (net.Listener, error) {
+	if conn, err := net.DialTimeout("unix", address, time.Second); err == nil {
+		conn.Close()
+		return nil, fmt.Errorf("%s is in use by a running instance", address)
+	}
+	// The private directory guards the socket until it is restricted, the umask is process wide and left alone
+	directory := filepath.Dir(address)
+	if err := os.MkdirAll(directory, 0700); err != nil {
+		return nil, err
+	}
+	if err := os.Chmod(directory, 0700); err != nil {
+		return nil, err
+	}
+	os.Remove(address)
+	listener, err := net.Listen("unix", address)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.Chmod(address, 0600); err != nil {
+		listener.Close()
+		return nil, err
+	}
+	return listener, nil
+}
+
+func DialControl(reqContext context.Context, address string) (net.Conn, error) {
+	var dialer net.Dialer
+	return dialer.DialContext(reqContext, "unix", address)
+}
@@ -0,0 +1,166 @@
+//go:build linux || darwin
+// +build linux darwin
+
+package main
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestListenControlPermissions(t *testing.T) {
+	setupTestHome(t)
+	address := GetControlAddress()
+	mask := syscall.Umask(0022)
+	defer syscall.Umask(mask)
+
+	listener, err := ListenControl(address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+	if current := syscall.Umask(0022); current != 0022 {
+		t.Errorf("umask changed to %o", current)
+	}
+	info, err := os.Stat(filepath.Dir(address))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0700 {
+		t.Errorf("directory mode %o, want 700", info.Mode().Perm())
+	}
+	info, err = os.Stat(address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode()&os.ModeSocket == 0 || info.Mode().Perm() != 0600 {
+		t.Errorf("socket mode %s, want 0600 socket", info.Mode())
+	}
+}
+
+func TestListenControlRestrictsExistingDirectory(t *testing.T) {
+	setupTestHome(t)
+	address := GetControlAddress()
+	if err := os.MkdirAll(filepath.Dir(address), 0755); err != nil {
+		t.Fatal(err)
+	}
+	listener, err := ListenControl(address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+	info, err := os.Stat(filepath.Dir(address))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0700 {
+		t.Errorf("directory mode %o, want 700", info.Mode().Perm())
+	}
+}
+
+func TestListenControlInUseAndStale(t *testing.T) {
+	setupTestHome(t)
+	address := GetControlAddress()
+	listener, err := ListenControl(address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ListenControl(address); err == nil || !strings.Contains(err.Error(), "in use") {
+		t.Errorf("expected in use error, got %v", err)
+	}
+
+	// Leave the socket file behind like a crashed instance
+	listener.(*net.UnixListener).SetUnlinkOnClose(false)
+	listener.Close()
+	if _, err := os.Stat(address); err != nil {
+		t.Fatal(err)
+	}
+	listener, err = ListenControl(address)
+	if err != nil {
+		t.Fatalf("stale socket not replaced: %s", err)
+	}
+	listener.Close()
+}
+
+func TestControlServer(t *testing.T) {
+	setupTestHome(t)
+	writeTestKubeconfig(t, newTestKubeconfig("kind-kind"))
+	LoadKubeconfig(false)
+	newTestRoot(t)
+	StartControlServer()
+	defer StopControlServer()
+	if controlListener == nil {
+		t.Fatal("control server not started")
+	}
+
+	var statuses []ContextStatus
+	if err := CallControl("GET", "/contexts", nil, &statuses); err != nil {
+		t.Fatal(err)
+	}
+	if len(statuses) != 1 || statuses[0].Name != "kind-kind" || !statuses[0].Current {
+		t.Errorf("unexpected statuses %+v", statuses)
+	}
+
+	err := CallControl("POST", "/refresh", ControlTarget{Context: "missing"}, nil)
+	if err == nil || !strings.Contains(err.Error(), "context missing not found") {
+		t.Errorf("expected not found error, got %v", err)
+	}
+	if err := CallControl("DELETE", "/contexts", nil, nil); err == nil {
+		t.Error("expected method not allowed")
+	}
+}
+
+// TestControlTerminalRejectsNames only opens terminals on the split kubeconfig of a namespace, the launched command
+// records the KUBECONFIG it was given
+func TestControlTerminalRejectsNames(t *testing.T) {
+	home := setupTestHome(t)
+	writeTestKubeconfig(t, newTestKubeconfig("kind-kind", "other"))
+	LoadKubeconfig(false)
+	config, _ := GetContextKubeconfig("kind-kind")
+	WriteContextKubeconfig("kind-kind", config, []string{"default"}, NamespaceSourceProbe)
+	launched := filepath.Join(home, "launched")
+	setupTestConfig(t, func(config *Config) {
+		config.Shell.Command = []string{"sh", "-c", `echo "$KUBECONFIG" >> ` + launched}
+	})
+	newTestRoot(t)
+	StartControlServer()
+	defer StopControlServer()
+
+	for _, target := range []ControlTarget{
+		{Context: "kind-kind", Namespace: ""},
+		{Context: "kind-kind", Namespace: namespaceSourceFile},
+		{Context: "kind-kind", Namespace: "../other"},
+		{Context: "../..", Namespace: "default"},
+		{Context: "kind-kind/..", Namespace: "kind-kind"},
+	} {
+		err := CallControl("POST", "/terminal", target, nil)
+		if err == nil || !strings.Contains(err.Error(), "invalid name") {
+			t.Errorf("terminal for %+v returned %v", target, err)
+		}
+	}
+	err := CallControl("POST", "/terminal", ControlTarget{Context: "other", Namespace: "default"}, nil)
+	if err == nil || !strings.Contains(err.Error(), "no kubeconfig for namespace default of other") {
+		t.Errorf("terminal without split kubeconfig returned %v", err)
+	}
+
+	if err := CallControl("POST", "/terminal", ControlTarget{Context: "kind-kind", Namespace: "default"}, nil); err != nil {
+		t.Fatal(err)
+	}
+	want := filepath.Join(contextDirectory, "kind-kind", "default") + "\n"
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		content, _ := os.ReadFile(launched)
+		if string(content) == want {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("terminals launched with %q, want only %q", content, want)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -0,0 +1,28 @@
+//go:build windows
+// +build windows
+
+package main
+
+import (
+	"context"
+	"net"
+	"os"
+
+	"github.com/Microsoft/go-winio"
+)
+
+// Only the owner, the user running the tray, may open the pipe
+const controlPipeSecurity = "D:P(A;;GA;;;OW)"
+
+func GetControlAddress() string {
+	return `\\.\pipe\kube-tray-` + os.Getenv("USERNAME")
+}
+
+// ListenControl creates the control named pipe, failing if a running instance already owns it
+func ListenControl(address string) (net.Listener, error) {
+	return winio.ListenPipe(address, &winio.PipeConfig{SecurityDescriptor: controlPipeSecurity})
+}
+
+func DialControl(reqContext context.Context, address string) (net.Conn, error) {
+	return winio.DialPipeContext(reqContext, address)
+}
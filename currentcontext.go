@@ -0,0 +1,52 @@
+package main
+
+import (
+	"fmt"
+
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// SetCurrentContext switches current-context of the user's kubeconfig, the namespace of the context is
+// updated too unless empty, each change is written to the file defining it under the loading rules
+func SetCurrentContext(configAccess clientcmd.ConfigAccess, ctx string, ns string) error {
+	config, err := configAccess.GetStartingConfig()
+	if err != nil {
+		return err
+	}
+	context, ok := config.Contexts[ctx]
+	if !ok {
+		return fmt.Errorf("context %s not found", ctx)
+	}
+	config.CurrentContext = ctx
+	if ns != "" {
+		context.Namespace = ns
+	}
+	return clientcmd.ModifyConfig(configAccess, *config, true)
+}
+
+func (e *Element) ChannelWaitForSetCurrent(ctx string, ns string) {
+	e.ChannelWaitForClick(func() {
+		trayLog.Infof("Set current context %s | %s", ctx, ns)
+		if err := SetCurrentContext(clientcmd.NewDefaultClientConfigLoadingRules(), ctx, ns); err != nil {
+			ShowLastError(fmt.Errorf("unable to set current context %s: %w", ctx, err))
+			return
+		}
+		setCurrentContextName(ctx)
+		rootElement.MarkCurrentContext()
+	})
+}
+
+// MarkCurrentContext checks the context item of the current-context
+func (rootElement *Element) MarkCurrentContext() {
+	rootElement.mutex.Lock()
+	defer rootElement.mutex.Unlock()
+
+	current := GetCurrentContextName()
+	for ctx, ctxElement := range rootElement.Children {
+		if ctx == current {
+			ctxElement.MenuItem.Check()
+		} else {
+			ctxElement.MenuItem.Uncheck()
+		}
+	}
+}
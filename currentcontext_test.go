@@ -0,0 +1,96 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// TestSetCurrentContextTwoFiles switches to a context defined in the second KUBECONFIG file, its namespace is
+// written to that file and current-context to the first file, which takes precedence like for kubectl
+func TestSetCurrentContextTwoFiles(t *testing.T) {
+	home := setupTestHome(t)
+	first := filepath.Join(home, "first.yaml")
+	second := filepath.Join(home, "second.yaml")
+	if err := clientcmd.WriteToFile(*newTestKubeconfig("kind-a"), first); err != nil {
+		t.Fatal(err)
+	}
+	secondConfig := newTestKubeconfig("kind-b", "kind-c")
+	if err := clientcmd.WriteToFile(*secondConfig, second); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("KUBECONFIG", first+string(os.PathListSeparator)+second)
+
+	if err := SetCurrentContext(clientcmd.NewDefaultClientConfigLoadingRules(), "kind-c", "team-c"); err != nil {
+		t.Fatal(err)
+	}
+
+	firstConfig, err := clientcmd.LoadFromFile(first)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if firstConfig.CurrentContext != "kind-c" {
+		t.Errorf("first file current-context %q, want kind-c", firstConfig.CurrentContext)
+	}
+	if _, ok := firstConfig.Contexts["kind-c"]; ok {
+		t.Error("context kind-c copied to the first file")
+	}
+	secondConfig, err = clientcmd.LoadFromFile(second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ns := secondConfig.Contexts["kind-c"].Namespace; ns != "team-c" {
+		t.Errorf("second file namespace of kind-c %q, want team-c", ns)
+	}
+	if ns := secondConfig.Contexts["kind-b"].Namespace; ns != "" {
+		t.Errorf("second file namespace of kind-b changed to %q", ns)
+	}
+
+	merged, err := clientcmd.NewDefaultClientConfigLoadingRules().Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if merged.CurrentContext != "kind-c" || merged.Contexts["kind-c"].Namespace != "team-c" {
+		t.Errorf("merged current-context %q namespace %q, want kind-c team-c", merged.CurrentContext, merged.Contexts["kind-c"].Namespace)
+	}
+}
+
+// TestSetCurrentContextOwnFile switches within the first file, an empty namespace keeps the one of the context
+func TestSetCurrentContextOwnFile(t *testing.T) {
+	home := setupTestHome(t)
+	first := filepath.Join(home, "first.yaml")
+	second := filepath.Join(home, "second.yaml")
+	firstConfig := newTestKubeconfig("kind-a", "kind-b")
+	firstConfig.Contexts["kind-b"].Namespace = "team-b"
+	if err := clientcmd.WriteToFile(*firstConfig, first); err != nil {
+		t.Fatal(err)
+	}
+	if err := clientcmd.WriteToFile(*newTestKubeconfig("kind-c"), second); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("KUBECONFIG", first+string(os.PathListSeparator)+second)
+
+	if err := SetCurrentContext(clientcmd.NewDefaultClientConfigLoadingRules(), "kind-b", ""); err != nil {
+		t.Fatal(err)
+	}
+	firstConfig, err := clientcmd.LoadFromFile(first)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if firstConfig.CurrentContext != "kind-b" || firstConfig.Contexts["kind-b"].Namespace != "team-b" {
+		t.Errorf("first file current-context %q namespace %q, want kind-b team-b", firstConfig.CurrentContext, firstConfig.Contexts["kind-b"].Namespace)
+	}
+	secondConfig, err := clientcmd.LoadFromFile(second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if secondConfig.CurrentContext != "kind-c" {
+		t.Errorf("second file current-context changed to %q", secondConfig.CurrentContext)
+	}
+
+	if err := SetCurrentContext(clientcmd.NewDefaultClientConfigLoadingRules(), "missing", ""); err == nil {
+		t.Error("expected an error for a missing context")
+	}
+}
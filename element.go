@@ -1,24 +1,43 @@
 package main
 
 import (
-	"github.com/getlantern/systray"
+	"fmt"
+	"sync"
+
 	"k8s.io/client-go/kubernetes"
 )
 
 type Element struct {
-	Title             string
-	MenuItem          *systray.MenuItem
-	Children          map[string]*Element
-	Client            *kubernetes.Clientset
-	ActionInitialized bool
-	Updated           bool
-	Locked            bool
+	Title           string
+	NamespaceSource string
+	// No namespace of the context is accessible to the user
+	NoNamespaces bool
+	// Why the context can't be used, its entry is disabled
+	Invalid  string
+	Health   ContextHealth
+	Menu     Menu
+	MenuItem MenuItem
+	Children map[string]*Element
+	Disposed map[string]*Element
+	Client   *kubernetes.Clientset
+	Updated  bool
+	Locked   bool
+	// Launch action entry of a namespace
+	Action bool
+
+	clickHandler func()
+	stopCh       chan struct{}
+
+	// Guards the whole tree, only used on the root element
+	mutex sync.Mutex
 }
 
-func NewRoot() *Element {
+func NewRoot(menu Menu) *Element {
 	return &Element{
+		Menu:     menu,
 		MenuItem: nil,
 		Children: map[string]*Element{},
+		Disposed: map[string]*Element{},
 		Updated:  true,
 		Locked:   true,
 	}
@@ -27,8 +46,10 @@ func NewRoot() *Element {
 func (e *Element) AddChild(title string, locked bool) *Element {
 	element := &Element{
 		Title:    title,
+		Menu:     e.Menu,
 		MenuItem: e.MenuItem.AddSubMenuItem(title, title),
 		Children: map[string]*Element{},
+		Disposed: map[string]*Element{},
 		Client:   e.Client,
 		Updated:  true,
 		Locked:   locked,
@@ -37,52 +58,212 @@ func (e *Element) AddChild(title string, locked bool) *Element {
 	return element
 }
 
+// UpsertChild returns the child with the title, reviving or creating it if needed
+func (e *Element) UpsertChild(title string, locked bool) *Element {
+	if existingElement, ok := e.Children[title]; ok {
+		existingElement.Updated = true
+		return existingElement
+	}
+	if disposedElement, ok := e.Revive(title); ok {
+		return disposedElement
+	}
+	return e.AddChild(title, locked)
+}
+
 func (rootElement *Element) UpsertContext(ctx string) *Element {
 	if existingCtxElement, ok := rootElement.Children[ctx]; ok {
 		existingCtxElement.Updated = true
 		return existingCtxElement
 	}
+	if disposedCtxElement, ok := rootElement.Revive(ctx); ok {
+		return disposedCtxElement
+	}
 	ctxElement := &Element{
 		Title:    ctx,
-		MenuItem: systray.AddMenuItem(ctx, ctx),
+		Menu:     rootElement.Menu,
+		MenuItem: rootElement.Menu.AddMenuItemCheckbox(ctx, ctx, ctx == GetCurrentContextName()),
 		Children: map[string]*Element{},
+		Disposed: map[string]*Element{},
 		Updated:  true,
 	}
 	rootElement.Children[ctx] = ctxElement
 	// ctxElement.AddChild("Launch console on this context", true).ChannelWaitForShell(ctxElement.Title)
 	ctxElement.AddChild("Refresh", true).ChannelWaitForManualRefresh(ctxElement.Title)
+	ctxElement.AddChild("Set as current", true).ChannelWaitForSetCurrent(ctxElement.Title, "")
+	if IsEventWatched(ctx) {
+		ctxElement.SetRecentEvents(GetRecentEvents(ctx))
+	}
 	seperator := ctxElement.MenuItem.AddSubMenuItem("", "")
 	seperator.Disable()
 	return ctxElement
 }
 
+func (rootElement *Element) DisposeContext(ctx string) {
+	rootElement.mutex.Lock()
+	defer rootElement.mutex.Unlock()
+	if ctxElement, ok := rootElement.Children[ctx]; ok {
+		ctxElement.ElementTraversalMarkNonUpdated()
+		rootElement.DisposeChildNonUpdated(ctx)
+	}
+}
+
 func (ctxElement *Element) UpsertNamespace(ns string) *Element {
 	if existingNsElement, ok := ctxElement.Children[ns]; ok {
 		existingNsElement.Updated = true
 		return existingNsElement
 	}
-	ctxElement.AddChild(ns, false).ChannelWaitForShell(ctxElement.Title, ns)
-	return ctxElement
+	if disposedNsElement, ok := ctxElement.Revive(ns); ok {
+		return disposedNsElement
+	}
+	nsElement := ctxElement.AddChild(ns, false)
+	nsElement.SyncActions(ctxElement.Title)
+	nsElement.AddChild("Set as current", true).ChannelWaitForSetCurrent(ctxElement.Title, ns)
+	nsElement.AddWorkloadsMenu(ctxElement.Title, ns)
+	nsElement.AddPodsMenu(ctxElement.Title, ns)
+	nsElement.AddPortForwardMenu(ctxElement.Title, ns)
+	return nsElement
+}
+
+func (ctxElement *Element) SetNamespaceSource(source string) {
+	if ctxElement.NamespaceSource == source {
+		return
+	}
+	ctxElement.NamespaceSource = source
+	ctxElement.updateContextTitle()
+}
+
+func (ctxElement *Element) SetNoNamespaces(noNamespaces bool) {
+	if ctxElement.NoNamespaces == noNamespaces {
+		return
+	}
+	ctxElement.NoNamespaces = noNamespaces
+	ctxElement.updateContextTitle()
+}
+
+func (ctxElement *Element) SetInvalid(reason string) {
+	if ctxElement.Invalid == reason {
+		return
+	}
+	ctxElement.Invalid = reason
+	if reason != "" {
+		ctxElement.MenuItem.Disable()
+	} else {
+		ctxElement.MenuItem.Enable()
+	}
+	ctxElement.updateContextTitle()
+}
+
+// upsertInvalidContexts shows the contexts with dangling references as disabled entries and disposes the
+// entries of invalid contexts removed from the kubeconfig
+func (rootElement *Element) upsertInvalidContexts() {
+	invalid := GetInvalidContexts()
+	for ctx, reason := range invalid {
+		ctxElement := rootElement.UpsertContext(ctx)
+		ctxElement.ElementTraversalMarkUpdated()
+		ctxElement.SetInvalid(reason)
+	}
+	for ctx, ctxElement := range rootElement.Children {
+		if _, ok := invalid[ctx]; ok || ctxElement.Invalid == "" {
+			continue
+		}
+		if _, ok := GetContextKubeconfig(ctx); !ok {
+			ctxElement.ElementTraversalMarkNonUpdated()
+			rootElement.DisposeChildNonUpdated(ctx)
+		}
+	}
+}
+
+// SyncInvalidContexts updates the entries of the contexts with dangling references
+func (rootElement *Element) SyncInvalidContexts() {
+	rootElement.mutex.Lock()
+	defer rootElement.mutex.Unlock()
+	rootElement.upsertInvalidContexts()
+}
+
+func (ctxElement *Element) SetHealth(health ContextHealth) {
+	if ctxElement.Health == health {
+		return
+	}
+	ctxElement.Health = health
+	ctxElement.updateContextTitle()
+}
+
+// updateContextTitle decorates the context item with its health and how its namespaces were found
+func (ctxElement *Element) updateContextTitle() {
+	title := ctxElement.Title
+	tooltip := ctxElement.Title
+	if ctxElement.Invalid != "" {
+		ctxElement.MenuItem.SetTitle(fmt.Sprintf("%s (invalid)", title))
+		ctxElement.MenuItem.SetTooltip(fmt.Sprintf("%s: %s", tooltip, ctxElement.Invalid))
+		return
+	}
+	if status := ctxElement.Health.String(); status != "" {
+		title = fmt.Sprintf("%s [%s]", title, status)
+		tooltip = fmt.Sprintf("%s: %s", tooltip, status)
+		if ctxElement.Health.Message != "" {
+			tooltip = fmt.Sprintf("%s (%s)", tooltip, ctxElement.Health.Message)
+		}
+	}
+	if ctxElement.NoNamespaces {
+		title = fmt.Sprintf("%s (no accessible namespaces)", title)
+		tooltip = fmt.Sprintf("%s\nNo accessible namespace found, set the namespace of the context or its namespaces in config.yaml", tooltip)
+	} else if ctxElement.NamespaceSource != NamespaceSourceList {
+		title = fmt.Sprintf("%s (namespaces %s)", title, ctxElement.NamespaceSource)
+		tooltip = fmt.Sprintf("%s\nListing namespaces is forbidden, namespaces %s", tooltip, ctxElement.NamespaceSource)
+	}
+	ctxElement.MenuItem.SetTitle(title)
+	ctxElement.MenuItem.SetTooltip(tooltip)
 }
 
 func (e *Element) ChannelWaitForManualRefresh(ctx string) {
-	go func() {
-		for range e.MenuItem.ClickedCh {
-			trayLog.Infof("Refresh for %s", ctx)
-			rootElement.UpdateContextData(ctx)
+	e.ChannelWaitForClick(func() {
+		trayLog.Infof("Refresh for %s", ctx)
+		rootElement.UpdateContextData(ctx)
+	})
+}
+
+func (e *Element) ChannelWaitForAction(ctx string, ns string, action string) {
+	e.ChannelWaitForClick(func() {
+		trayLog.Infof("Run %s for %s | %s", action, ctx, ns)
+		if err := RunAction(action, ctx, ns); err != nil {
+			ShowLastError(fmt.Errorf("unable to run %s for %s | %s: %w", action, ctx, ns, err))
 		}
-	}()
+	})
 }
 
-func (e *Element) ChannelWaitForShell(ctx string, ns string) {
+// ChannelWaitForClick runs the handler on every click until the element is disposed
+func (e *Element) ChannelWaitForClick(handler func()) {
+	e.clickHandler = handler
+	e.startClickListener()
+}
+
+func (e *Element) startClickListener() {
+	if e.clickHandler == nil || e.stopCh != nil {
+		return
+	}
+	stopCh := make(chan struct{})
+	e.stopCh = stopCh
+	clickedCh := e.MenuItem.ClickedCh()
+	handler := e.clickHandler
 	go func() {
-		for range e.MenuItem.ClickedCh {
-			trayLog.Infof("Open shell for %s | %s", ctx, ns)
-			OpenTerminal(ctx, ns)
+		for {
+			select {
+			case <-clickedCh:
+				handler()
+			case <-stopCh:
+				return
+			}
 		}
 	}()
 }
 
+func (e *Element) stopClickListener() {
+	if e.stopCh != nil {
+		close(e.stopCh)
+		e.stopCh = nil
+	}
+}
+
 func (e *Element) ElementTraversalMarkNonUpdated() {
 	if !e.Locked {
 		e.Updated = false
@@ -92,18 +273,64 @@ func (e *Element) ElementTraversalMarkNonUpdated() {
 	}
 }
 
+func (e *Element) ElementTraversalMarkUpdated() {
+	e.Updated = true
+	for _, childElement := range e.Children {
+		childElement.ElementTraversalMarkUpdated()
+	}
+}
+
 func (e *Element) ElementTraversalDisposeNonUpdated() {
-	if !e.Updated {
-		e.MenuItem.Hide()
-		// Force hide and close channel on gone resources
-		if e.ActionInitialized {
-			for _, childElement := range e.Children {
-				childElement.MenuItem.Hide()
-				close(childElement.MenuItem.ClickedCh)
-			}
+	for title := range e.Children {
+		e.DisposeChildNonUpdated(title)
+	}
+}
+
+// DisposeChildNonUpdated disposes the child if it is gone, otherwise its gone descendants
+func (e *Element) DisposeChildNonUpdated(title string) {
+	childElement, ok := e.Children[title]
+	if !ok {
+		return
+	}
+	if childElement.Updated {
+		childElement.ElementTraversalDisposeNonUpdated()
+		return
+	}
+	childElement.dispose()
+	delete(e.Children, title)
+	e.Disposed[title] = childElement
+}
+
+// dispose hides the element and stops its click listeners, locked children are kept for a later revival
+func (e *Element) dispose() {
+	e.MenuItem.Hide()
+	e.stopClickListener()
+	for title, childElement := range e.Children {
+		childElement.dispose()
+		if !childElement.Locked {
+			delete(e.Children, title)
+			e.Disposed[title] = childElement
 		}
 	}
+}
+
+// Revive re-shows a disposed child when its resource comes back
+func (e *Element) Revive(title string) (*Element, bool) {
+	childElement, ok := e.Disposed[title]
+	if !ok {
+		return nil, false
+	}
+	delete(e.Disposed, title)
+	e.Children[title] = childElement
+	childElement.show()
+	return childElement, true
+}
+
+func (e *Element) show() {
+	e.Updated = true
+	e.MenuItem.Show()
+	e.startClickListener()
 	for _, childElement := range e.Children {
-		childElement.ElementTraversalDisposeNonUpdated()
+		childElement.show()
 	}
 }
@@ -0,0 +1,171 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// newTestRoot sets up the root element on an in-memory menu
+func newTestRoot(t *testing.T) (*Element, *MemoryMenu) {
+	t.Helper()
+	menu := NewMemoryMenu()
+	root := NewRoot(menu)
+	rootElement = root
+	t.Cleanup(func() {
+		rootElement = nil
+	})
+	return root, menu
+}
+
+func assertVisible(t *testing.T, item *MemoryMenuItem, visible bool, titles ...string) {
+	t.Helper()
+	if item == nil {
+		t.Fatalf("%v: item not found", titles)
+	}
+	if item.Visible() != visible {
+		t.Errorf("%v: visible = %v, want %v", titles, item.Visible(), visible)
+	}
+}
+
+func TestUpsertNamespace(t *testing.T) {
+	root, menu := newTestRoot(t)
+	ctxElement := root.UpsertContext("kind-kind")
+	nsElement := ctxElement.UpsertNamespace("default")
+
+	for _, title := range []string{ShellAction, "Set as current", workloadsTitle, podsTitle, portForwardTargetsTitle} {
+		if _, ok := nsElement.Children[title]; !ok {
+			t.Errorf("namespace has no %q entry", title)
+		}
+		assertVisible(t, menu.Find("kind-kind", "default", title), true, "kind-kind", "default", title)
+	}
+
+	items := len(menu.Find("kind-kind").Children())
+	if again := ctxElement.UpsertNamespace("default"); again != nsElement {
+		t.Error("upserting an existing namespace created a new element")
+	}
+	if got := len(menu.Find("kind-kind").Children()); got != items {
+		t.Errorf("upserting an existing namespace added %d menu items", got-items)
+	}
+}
+
+func TestDisposeNonUpdated(t *testing.T) {
+	root, menu := newTestRoot(t)
+	ctxElement := root.UpsertContext("kind-kind")
+	ctxElement.UpsertNamespace("default")
+	ctxElement.UpsertNamespace("kube-system")
+
+	root.ElementTraversalMarkNonUpdated()
+	root.UpsertContext("kind-kind").UpsertNamespace("default")
+	root.ElementTraversalDisposeNonUpdated()
+
+	assertVisible(t, menu.Find("kind-kind", "default"), true, "default")
+	assertVisible(t, menu.Find("kind-kind", "kube-system"), false, "kube-system")
+	if _, ok := ctxElement.Disposed["kube-system"]; !ok {
+		t.Error("kube-system was not moved to the disposed elements")
+	}
+	disposed := ctxElement.Disposed["kube-system"]
+	if disposed.stopCh != nil {
+		t.Error("disposed namespace still listens to clicks")
+	}
+	// Locked entries stay with their disposed namespace to be revived with it
+	if _, ok := disposed.Children["Set as current"]; !ok {
+		t.Error("locked entry was removed from the disposed namespace")
+	}
+	if disposed.Children["Set as current"].stopCh != nil {
+		t.Error("locked entry of the disposed namespace still listens to clicks")
+	}
+}
+
+func TestApplyContextDataRefresh(t *testing.T) {
+	root, menu := newTestRoot(t)
+	refresh := func(data ContextData) {
+		root.ElementTraversalMarkNonUpdated()
+		root.ApplyContextData(data)
+		root.ElementTraversalDisposeNonUpdated()
+	}
+
+	refresh(ContextData{Context: "kind-kind", Namespaces: []string{"default", "kube-system"}})
+	assertVisible(t, menu.Find("kind-kind", "default"), true, "default")
+	assertVisible(t, menu.Find("kind-kind", "kube-system"), true, "kube-system")
+	items := len(menu.Find("kind-kind").Children())
+
+	refresh(ContextData{Context: "kind-kind", Namespaces: []string{"default"}})
+	assertVisible(t, menu.Find("kind-kind", "kube-system"), false, "kube-system")
+
+	// A failed refresh keeps the last known namespaces
+	refresh(ContextData{Context: "kind-kind", Err: errors.New("unreachable")})
+	assertVisible(t, menu.Find("kind-kind"), true, "kind-kind")
+	assertVisible(t, menu.Find("kind-kind", "default"), true, "default")
+
+	refresh(ContextData{Context: "kind-kind", Namespaces: []string{"default", "kube-system"}})
+	assertVisible(t, menu.Find("kind-kind", "kube-system"), true, "kube-system")
+	if got := len(menu.Find("kind-kind").Children()); got != items {
+		t.Errorf("reviving kube-system added %d menu items", got-items)
+	}
+}
+
+func TestRefreshRemovesAndRevivesNamespaces(t *testing.T) {
+	clientset := newFakeClientset("default", "team-a")
+	setupTestClusters(t, map[string]kubernetes.Interface{"kind-kind": clientset})
+	root, menu := newTestRoot(t)
+
+	root.UpdateData()
+	assertVisible(t, menu.Find("kind-kind", "team-a"), true, "team-a")
+	nsElement := root.Children["kind-kind"].Children["team-a"]
+	items := len(menu.Find("kind-kind").Children())
+
+	if err := clientset.CoreV1().Namespaces().Delete(context.Background(), "team-a", metav1.DeleteOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	root.UpdateData()
+	assertVisible(t, menu.Find("kind-kind", "team-a"), false, "team-a")
+	assertVisible(t, menu.Find("kind-kind", "default"), true, "default")
+
+	if _, err := clientset.CoreV1().Namespaces().Create(context.Background(), &v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a"}}, metav1.CreateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	root.UpdateData()
+	assertVisible(t, menu.Find("kind-kind", "team-a"), true, "team-a")
+	assertVisible(t, menu.Find("kind-kind", "team-a", "Set as current"), true, "team-a", "Set as current")
+	if root.Children["kind-kind"].Children["team-a"] != nsElement {
+		t.Error("re-added namespace was not revived")
+	}
+	if got := len(menu.Find("kind-kind").Children()); got != items {
+		t.Errorf("re-adding team-a added %d menu items", got-items)
+	}
+}
+
+func TestKubeconfigChangeRemovesAndRevivesContexts(t *testing.T) {
+	setupTestClusters(t, map[string]kubernetes.Interface{
+		"kind-a": newFakeClientset("default"),
+		"kind-b": newFakeClientset("default"),
+	})
+	root, menu := newTestRoot(t)
+	root.UpdateData()
+	ctxElement := root.Children["kind-b"]
+	items := len(menu.Items())
+
+	writeTestKubeconfig(t, newTestKubeconfig("kind-a"))
+	onKubeconfigChanged()
+	assertVisible(t, menu.Find("kind-b"), false, "kind-b")
+	assertVisible(t, menu.Find("kind-a", "default"), true, "kind-a", "default")
+	if _, ok := root.Disposed["kind-b"]; !ok {
+		t.Error("removed context was not disposed")
+	}
+
+	writeTestKubeconfig(t, newTestKubeconfig("kind-a", "kind-b"))
+	onKubeconfigChanged()
+	assertVisible(t, menu.Find("kind-b"), true, "kind-b")
+	assertVisible(t, menu.Find("kind-b", "default"), true, "kind-b", "default")
+	if root.Children["kind-b"] != ctxElement {
+		t.Error("re-added context was not revived")
+	}
+	if got := len(menu.Items()); got != items {
+		t.Errorf("re-adding kind-b added %d menu items", got-items)
+	}
+}
@@ -0,0 +1,310 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+const (
+	recentEventsTitle      = "Recent events"
+	eventWatchRetryDelay   = 10 * time.Second
+	eventTitleMessageLimit = 80
+)
+
+// WarningEvent is a Warning event listed in the Recent events submenu of its context
+type WarningEvent struct {
+	Context   string
+	Namespace string
+	Object    string
+	Reason    string
+	Message   string
+	Count     int32
+	LastSeen  time.Time
+}
+
+// eventWatch watches the warnings of a context with the settings it was started with
+type eventWatch struct {
+	stopCh     chan struct{}
+	namespaces []string
+	reasons    []string
+}
+
+var (
+	eventsMutex  sync.Mutex
+	eventWatches = map[string]eventWatch{}
+	recentEvents = map[string][]WarningEvent{}
+)
+
+func NewWarningEvent(ctx string, event v1.Event) WarningEvent {
+	lastSeen := event.LastTimestamp.Time
+	if lastSeen.IsZero() {
+		lastSeen = event.EventTime.Time
+	}
+	if lastSeen.IsZero() {
+		lastSeen = event.CreationTimestamp.Time
+	}
+	return WarningEvent{
+		Context:   ctx,
+		Namespace: event.Namespace,
+		Object:    fmt.Sprintf("%s/%s", strings.ToLower(event.InvolvedObject.Kind), event.InvolvedObject.Name),
+		Reason:    event.Reason,
+		Message:   strings.TrimSpace(event.Message),
+		Count:     event.Count,
+		LastSeen:  lastSeen,
+	}
+}
+
+// Key identifies repeated occurrences of the same warning
+func (event WarningEvent) Key() string {
+	return fmt.Sprintf("%s | %s | %s | %s", event.Context, event.Namespace, event.Object, event.Reason)
+}
+
+func (event WarningEvent) String() string {
+	message := event.Message
+	if len(message) > eventTitleMessageLimit {
+		message = message[:eventTitleMessageLimit] + "..."
+	}
+	return fmt.Sprintf("%s %s %s/%s: %s", event.LastSeen.Local().Format("15:04"), event.Reason, event.Namespace, event.Object, message)
+}
+
+// IsEventWatched tells whether the context is selected by `events.contexts`
+func IsEventWatched(ctx string) bool {
+	for _, pattern := range GetConfig().Events.Contexts {
+		if MatchContext(pattern, ctx) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsReportedReason tells whether warnings with the reason are reported, all are unless `events.reasons` is set
+func IsReportedReason(reason string) bool {
+	reasons := GetConfig().Events.Reasons
+	if len(reasons) == 0 {
+		return true
+	}
+	for _, reported := range reasons {
+		if strings.EqualFold(reported, reason) {
+			return true
+		}
+	}
+	return false
+}
+
+// UpdateEventWatches starts watching the contexts selected by `events.contexts` and stops the others, watches
+// started with other `events.namespaces` or `events.reasons` are restarted to list the warnings again
+func UpdateEventWatches() {
+	selected := map[string]bool{}
+	for _, ctx := range GetKubeconfigContexts() {
+		if IsEventWatched(ctx) {
+			selected[ctx] = true
+		}
+	}
+	config := GetConfig()
+	namespaces := config.Events.Namespaces
+	if len(namespaces) == 0 {
+		namespaces = []string{metav1.NamespaceAll}
+	}
+	reasons := config.Events.Reasons
+
+	stopped := []string{}
+	eventsMutex.Lock()
+	for ctx, watching := range eventWatches {
+		changed := !reflect.DeepEqual(watching.namespaces, namespaces) || !reflect.DeepEqual(watching.reasons, reasons)
+		if selected[ctx] && !changed {
+			continue
+		}
+		if selected[ctx] {
+			kubeLog.Infof("Restart watching events of [%s] with changed settings", ctx)
+		} else {
+			kubeLog.Infof("Stop watching events of [%s]", ctx)
+		}
+		close(watching.stopCh)
+		delete(eventWatches, ctx)
+		delete(recentEvents, ctx)
+		stopped = append(stopped, ctx)
+	}
+	for ctx := range selected {
+		if _, ok := eventWatches[ctx]; ok {
+			continue
+		}
+		kubeLog.Infof("Watch events of [%s]", ctx)
+		started := eventWatch{stopCh: make(chan struct{}), namespaces: namespaces, reasons: reasons}
+		eventWatches[ctx] = started
+		for _, ns := range namespaces {
+			go watchWarningEvents(ctx, ns, started.stopCh)
+		}
+	}
+	eventsMutex.Unlock()
+
+	for _, ctx := range stopped {
+		rootElement.UpdateRecentEvents(ctx)
+	}
+}
+
+// GetRecentEvents returns the last warnings of the context, newest first
+func GetRecentEvents(ctx string) []WarningEvent {
+	eventsMutex.Lock()
+	defer eventsMutex.Unlock()
+	return append([]WarningEvent{}, recentEvents[ctx]...)
+}
+
+// AddRecentEvent records the warning, replacing earlier occurrences, and keeps the last `events.recent` ones
+func AddRecentEvent(event WarningEvent) {
+	eventsMutex.Lock()
+	defer eventsMutex.Unlock()
+	events := []WarningEvent{event}
+	for _, existing := range recentEvents[event.Context] {
+		if existing.Key() != event.Key() {
+			events = append(events, existing)
+		}
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].LastSeen.After(events[j].LastSeen)
+	})
+	if limit := GetConfig().Events.Recent; len(events) > limit {
+		events = events[:limit]
+	}
+	recentEvents[event.Context] = events
+}
+
+func watchWarningEvents(ctx string, ns string, stopCh chan struct{}) {
+	resourceVersion := ""
+	for {
+		err := watchWarningEventsOnce(ctx, ns, stopCh, &resourceVersion)
+		select {
+		case <-stopCh:
+			return
+		default:
+		}
+		// A watch which timed out resumes where it ended, nothing is missed while waiting
+		if err != nil {
+			kubeLog.Warningf("Unable to watch events of [%s | %s]: %s", ctx, ns, err)
+			resourceVersion = ""
+		}
+		select {
+		case <-stopCh:
+			return
+		case <-time.After(eventWatchRetryDelay):
+		}
+	}
+}
+
+// watchWarningEventsOnce notifies the warnings seen by the watch, without resource version the current warnings
+// are listed first without notifying
+func watchWarningEventsOnce(ctx string, ns string, stopCh chan struct{}, resourceVersion *string) error {
+	config, ok := GetContextKubeconfig(ctx)
+	if !ok {
+		return fmt.Errorf("context %s not found", ctx)
+	}
+	clientset, err := NewClientset(config)
+	if err != nil {
+		return err
+	}
+	reqContext, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-stopCh:
+			cancel()
+		case <-reqContext.Done():
+		}
+	}()
+
+	options := metav1.ListOptions{FieldSelector: fields.OneTermEqualSelector("type", v1.EventTypeWarning).String()}
+	if *resourceVersion == "" {
+		listContext, listCancel := context.WithTimeout(reqContext, GetConfig().AutoRefresh.Timeout.Duration())
+		events, err := clientset.CoreV1().Events(ns).List(listContext, options)
+		listCancel()
+		if err != nil {
+			return err
+		}
+		for _, event := range events.Items {
+			if IsReportedReason(event.Reason) {
+				AddRecentEvent(NewWarningEvent(ctx, event))
+			}
+		}
+		rootElement.UpdateRecentEvents(ctx)
+		*resourceVersion = events.ResourceVersion
+	}
+
+	options.ResourceVersion = *resourceVersion
+	watcher, err := clientset.CoreV1().Events(ns).Watch(reqContext, options)
+	if err != nil {
+		return err
+	}
+	defer watcher.Stop()
+	for result := range watcher.ResultChan() {
+		switch result.Type {
+		case watch.Error:
+			return apierrors.FromObject(result.Object)
+		case watch.Added, watch.Modified:
+			event, ok := result.Object.(*v1.Event)
+			if !ok {
+				continue
+			}
+			*resourceVersion = event.ResourceVersion
+			if !IsReportedReason(event.Reason) {
+				continue
+			}
+			warning := NewWarningEvent(ctx, *event)
+			AddRecentEvent(warning)
+			NotifyWarningEvent(warning)
+			rootElement.UpdateRecentEvents(ctx)
+		}
+	}
+	return nil
+}
+
+func NotifyWarningEvent(event WarningEvent) {
+	err := notifier.Notify(Notification{
+		Key:     event.Key(),
+		Title:   fmt.Sprintf("%s: %s", event.Reason, event.Object),
+		Message: fmt.Sprintf("%s | %s\n%s", event.Context, event.Namespace, event.Message),
+	})
+	if err != nil {
+		trayLog.Warningf("Unable to notify %s: %s", event.Key(), err)
+	}
+}
+
+func (rootElement *Element) UpdateRecentEvents(ctx string) {
+	events := GetRecentEvents(ctx)
+
+	rootElement.mutex.Lock()
+	defer rootElement.mutex.Unlock()
+	if ctxElement, ok := rootElement.Children[ctx]; ok {
+		ctxElement.SetRecentEvents(events)
+	}
+}
+
+// SetRecentEvents shows the last warnings of the context, entries are reused in place to keep them ordered
+func (ctxElement *Element) SetRecentEvents(events []WarningEvent) {
+	recentElement := ctxElement.UpsertChild(recentEventsTitle, true)
+	recentElement.MenuItem.SetTitle(fmt.Sprintf("%s (%d)", recentEventsTitle, len(events)))
+	for i, event := range events {
+		eventElement := recentElement.UpsertChild(strconv.Itoa(i+1), true)
+		eventElement.MenuItem.SetTitle(event.String())
+		eventElement.MenuItem.SetTooltip(event.Message)
+		eventElement.MenuItem.Disable()
+		eventElement.MenuItem.Show()
+	}
+	for i := len(events) + 1; ; i++ {
+		eventElement, ok := recentElement.Children[strconv.Itoa(i)]
+		if !ok {
+			break
+		}
+		eventElement.MenuItem.Hide()
+	}
+}
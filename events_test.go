@@ -0,0 +1,202 @@
+package main
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func newWarningEvent(ns string, name string, reason string, lastSeen time.Time) *v1.Event {
+	return &v1.Event{
+		ObjectMeta:     metav1.ObjectMeta{Namespace: ns, Name: name + "." + reason},
+		InvolvedObject: v1.ObjectReference{Kind: "Pod", Name: name},
+		Type:           v1.EventTypeWarning,
+		Reason:         reason,
+		Message:        reason + " of " + name,
+		Count:          1,
+		LastTimestamp:  metav1.NewTime(lastSeen),
+	}
+}
+
+func clearRecentEvents(t *testing.T, ctx string) {
+	t.Cleanup(func() {
+		eventsMutex.Lock()
+		delete(recentEvents, ctx)
+		eventsMutex.Unlock()
+	})
+}
+
+func TestAddRecentEventOrderAndLimit(t *testing.T) {
+	setupTestConfig(t, func(config *Config) {
+		config.Events.Recent = 3
+	})
+	clearRecentEvents(t, "kind-kind")
+	now := time.Now()
+	for i, event := range []struct {
+		pod    string
+		reason string
+	}{
+		{"a", "BackOff"},
+		{"c", "BackOff"},
+		{"b", "Failed"},
+		{"d", "Unhealthy"},
+	} {
+		// b is seen before c
+		lastSeen := now.Add(time.Duration(i) * time.Minute)
+		if event.pod == "b" {
+			lastSeen = now.Add(-time.Minute)
+		}
+		AddRecentEvent(NewWarningEvent("kind-kind", *newWarningEvent("default", event.pod, event.reason, lastSeen)))
+	}
+	assertRecentEvents(t, "kind-kind", "pod/d", "pod/c", "pod/a")
+
+	// A repeated warning replaces its earlier occurrence instead of taking another entry
+	AddRecentEvent(NewWarningEvent("kind-kind", *newWarningEvent("default", "a", "BackOff", now.Add(time.Hour))))
+	assertRecentEvents(t, "kind-kind", "pod/a", "pod/d", "pod/c")
+}
+
+func assertRecentEvents(t *testing.T, ctx string, objects ...string) {
+	t.Helper()
+	got := []string{}
+	for _, event := range GetRecentEvents(ctx) {
+		got = append(got, event.Object)
+	}
+	if len(got) != len(objects) {
+		t.Fatalf("recent events %v, want %v", got, objects)
+	}
+	for i := range objects {
+		if got[i] != objects[i] {
+			t.Fatalf("recent events %v, want %v", got, objects)
+		}
+	}
+}
+
+// TestWatchWarningEvents lists the current warnings without notifying them and notifies the watched ones
+func TestWatchWarningEvents(t *testing.T) {
+	now := time.Now()
+	clientset := newFakeClientset("default")
+	clientset.Tracker().Add(newWarningEvent("default", "old", "BackOff", now.Add(-time.Hour)))
+	events := watch.NewFake()
+	clientset.PrependWatchReactor("events", func(action k8stesting.Action) (bool, watch.Interface, error) {
+		return true, events, nil
+	})
+	setupTestClusters(t, map[string]kubernetes.Interface{"kind-kind": clientset})
+	clearRecentEvents(t, "kind-kind")
+	root, menu := newTestRoot(t)
+	root.UpdateData()
+	recorder := &RecordingNotifier{}
+	notifier = recorder
+	t.Cleanup(func() {
+		notifier = LogNotifier{}
+	})
+
+	done := make(chan error)
+	go func() {
+		resourceVersion := ""
+		done <- watchWarningEventsOnce("kind-kind", "default", make(chan struct{}), &resourceVersion)
+	}()
+	events.Add(newWarningEvent("default", "new", "Failed", now))
+	events.Stop()
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+
+	notifications := recorder.Notifications()
+	if len(notifications) != 1 || notifications[0].Title != "Failed: pod/new" {
+		t.Errorf("notified %v, want only the watched warning", notifications)
+	}
+	assertRecentEvents(t, "kind-kind", "pod/new", "pod/old")
+	assertVisible(t, menu.Find("kind-kind", "Recent events (2)"), true, "Recent events (2)")
+}
+
+// TestUpdateEventWatchesSettingsChanged restarts the watch of a context when `events.namespaces` changes and keeps
+// it otherwise
+func TestUpdateEventWatchesSettingsChanged(t *testing.T) {
+	clientset := newFakeClientset("team-a", "team-b")
+	var watchMutex sync.Mutex
+	watched := []string{}
+	watches := []*watch.FakeWatcher{}
+	clientset.PrependWatchReactor("events", func(action k8stesting.Action) (bool, watch.Interface, error) {
+		watchMutex.Lock()
+		defer watchMutex.Unlock()
+		watched = append(watched, action.GetNamespace())
+		events := watch.NewFake()
+		watches = append(watches, events)
+		return true, events, nil
+	})
+	setupTestClusters(t, map[string]kubernetes.Interface{"kind-kind": clientset})
+	clearRecentEvents(t, "kind-kind")
+	newTestRoot(t)
+	t.Cleanup(func() {
+		eventsMutex.Lock()
+		for ctx, watching := range eventWatches {
+			close(watching.stopCh)
+			delete(eventWatches, ctx)
+		}
+		eventsMutex.Unlock()
+		watchMutex.Lock()
+		for _, events := range watches {
+			events.Stop()
+		}
+		watchMutex.Unlock()
+	})
+	waitWatched := func(want ...string) {
+		t.Helper()
+		deadline := time.Now().Add(5 * time.Second)
+		for {
+			watchMutex.Lock()
+			got := append([]string{}, watched...)
+			watchMutex.Unlock()
+			if reflect.DeepEqual(got, want) {
+				return
+			}
+			if time.Now().After(deadline) {
+				t.Fatalf("watched events of %v, want %v", got, want)
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+	currentStopCh := func() chan struct{} {
+		eventsMutex.Lock()
+		defer eventsMutex.Unlock()
+		return eventWatches["kind-kind"].stopCh
+	}
+
+	setupTestConfig(t, func(config *Config) {
+		config.Events.Contexts = []string{"kind-*"}
+		config.Events.Namespaces = []string{"team-a"}
+	})
+	UpdateEventWatches()
+	waitWatched("team-a")
+	stopCh := currentStopCh()
+
+	// Unrelated changes keep the watch
+	setupTestConfig(t, func(config *Config) {
+		config.Events.Contexts = []string{"kind-*"}
+		config.Events.Namespaces = []string{"team-a"}
+		config.Events.Recent = 5
+	})
+	UpdateEventWatches()
+	if currentStopCh() != stopCh {
+		t.Fatal("watch restarted without changed settings")
+	}
+
+	setupTestConfig(t, func(config *Config) {
+		config.Events.Contexts = []string{"kind-*"}
+		config.Events.Namespaces = []string{"team-b"}
+	})
+	UpdateEventWatches()
+	waitWatched("team-a", "team-b")
+	select {
+	case <-stopCh:
+	default:
+		t.Error("watch of team-a not stopped")
+	}
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/kubernetes"
+)
+
+type HealthStatus string
+
+const (
+	HealthUnknown      HealthStatus = ""
+	HealthHealthy      HealthStatus = "healthy"
+	HealthDegraded     HealthStatus = "degraded"
+	HealthUnreachable  HealthStatus = "unreachable"
+	HealthUnauthorized HealthStatus = "unauthorized"
+)
+
+// ContextHealth is the result of the last health probe of a context
+type ContextHealth struct {
+	Status   HealthStatus
+	Version  string
+	Message  string
+	ProbedAt time.Time
+}
+
+var (
+	healthMutex   sync.Mutex
+	contextHealth = map[string]ContextHealth{}
+)
+
+// GetContextHealth returns the cached health of the context
+func GetContextHealth(ctx string) ContextHealth {
+	healthMutex.Lock()
+	defer healthMutex.Unlock()
+	return contextHealth[ctx]
+}
+
+func setContextHealth(ctx string, health ContextHealth) {
+	healthMutex.Lock()
+	defer healthMutex.Unlock()
+	contextHealth[ctx] = health
+}
+
+// ProbeHealth checks /readyz, falling back to /healthz on older API servers, and fetches the server version
+func ProbeHealth(reqContext context.Context, clientset kubernetes.Interface) ContextHealth {
+	health := ContextHealth{ProbedAt: time.Now()}
+	restClient := clientset.Discovery().RESTClient()
+	if restClient == nil {
+		return health
+	}
+
+	var statusCode int
+	result := restClient.Get().AbsPath("/readyz").Do(reqContext).StatusCode(&statusCode)
+	if statusCode == http.StatusNotFound {
+		result = restClient.Get().AbsPath("/healthz").Do(reqContext).StatusCode(&statusCode)
+	}
+	health.Status = healthStatusOf(statusCode)
+	if err := result.Error(); err != nil {
+		health.Message = err.Error()
+	}
+	if health.Status == HealthUnreachable || health.Status == HealthUnauthorized {
+		return health
+	}
+
+	body, err := restClient.Get().AbsPath("/version").Do(reqContext).Raw()
+	if err != nil {
+		kubeLog.Warning(err)
+		return health
+	}
+	var info version.Info
+	if err := json.Unmarshal(body, &info); err != nil {
+		kubeLog.Warning(err)
+		return health
+	}
+	health.Version = info.GitVersion
+	return health
+}
+
+func healthStatusOf(statusCode int) HealthStatus {
+	switch {
+	case statusCode == 0:
+		// No response at all
+		return HealthUnreachable
+	case statusCode == http.StatusOK:
+		return HealthHealthy
+	case statusCode == http.StatusUnauthorized || statusCode == http.StatusForbidden:
+		return HealthUnauthorized
+	default:
+		return HealthDegraded
+	}
+}
+
+func (health ContextHealth) String() string {
+	if health.Status == HealthUnknown {
+		return ""
+	}
+	if health.Version == "" {
+		return string(health.Status)
+	}
+	return fmt.Sprintf("%s, %s", health.Status, health.Version)
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+func newTestServerClientset(t *testing.T, handler http.HandlerFunc) (*httptest.Server, kubernetes.Interface) {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	clientset, err := kubernetes.NewForConfig(&rest.Config{Host: server.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return server, clientset
+}
+
+func TestProbeHealth(t *testing.T) {
+	tests := []struct {
+		name    string
+		readyz  int
+		healthz int
+		want    ContextHealth
+	}{
+		{name: "healthy", readyz: http.StatusOK, want: ContextHealth{Status: HealthHealthy, Version: "v1.25.0"}},
+		{name: "healthz fallback", readyz: http.StatusNotFound, healthz: http.StatusOK, want: ContextHealth{Status: HealthHealthy, Version: "v1.25.0"}},
+		{name: "degraded", readyz: http.StatusInternalServerError, want: ContextHealth{Status: HealthDegraded, Version: "v1.25.0"}},
+		{name: "unauthorized", readyz: http.StatusUnauthorized, want: ContextHealth{Status: HealthUnauthorized}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, clientset := newTestServerClientset(t, func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/readyz":
+					w.WriteHeader(test.readyz)
+				case "/healthz":
+					w.WriteHeader(test.healthz)
+				case "/version":
+					w.Write([]byte(`{"gitVersion": "v1.25.0"}`))
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			})
+			health := ProbeHealth(context.Background(), clientset)
+			if health.Status != test.want.Status || health.Version != test.want.Version {
+				t.Errorf("health %s, want %s", health, test.want)
+			}
+		})
+	}
+}
+
+func TestProbeHealthUnreachable(t *testing.T) {
+	server, clientset := newTestServerClientset(t, func(w http.ResponseWriter, r *http.Request) {})
+	server.Close()
+	if health := ProbeHealth(context.Background(), clientset); health.Status != HealthUnreachable {
+		t.Errorf("health %s, want unreachable", health)
+	}
+}
+
+// TestCancelledProbeKeepsHealth cuts off the probe like a superseding refresh, the last health stays cached
+func TestCancelledProbeKeepsHealth(t *testing.T) {
+	setupTestHome(t)
+	server, _ := newTestServerClientset(t, func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	})
+	config := newTestKubeconfig("slow")
+	config.Clusters["slow"].Server = server.URL
+	writeTestKubeconfig(t, config)
+	LoadKubeconfig(false)
+	healthy := ContextHealth{Status: HealthHealthy, Version: "v1.25.0"}
+	setContextHealth("slow", healthy)
+
+	reqContext, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	data := FetchContextData(reqContext, "slow")
+	if !errors.Is(data.Err, context.DeadlineExceeded) {
+		t.Errorf("expected the deadline error, got %v", data.Err)
+	}
+	if got := GetContextHealth("slow"); got != healthy {
+		t.Errorf("cached health %s, want %s", got, healthy)
+	}
+	if data.Health != healthy {
+		t.Errorf("applied health %s, want %s", data.Health, healthy)
+	}
+}
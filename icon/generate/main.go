@@ -0,0 +1,126 @@
+//go:build ignore
+// +build ignore
+
+// Generates the status variants of the tray icon, run from the icon directory:
+//
+//	go run generate/main.go icon.png
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"os"
+	"strings"
+)
+
+var variants = []struct {
+	Name  string
+	Color color.RGBA
+}{
+	{"Healthy", color.RGBA{0x2e, 0xa0, 0x43, 0xff}},
+	{"Degraded", color.RGBA{0xf0, 0xa0, 0x20, 0xff}},
+	{"Refreshing", color.RGBA{0x1f, 0x6f, 0xeb, 0xff}},
+	{"Error", color.RGBA{0xd0, 0x30, 0x30, 0xff}},
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Println("Please specify a PNG file")
+		os.Exit(1)
+	}
+	file, err := os.Open(os.Args[1])
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	base, err := png.Decode(file)
+	file.Close()
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	var unix, windows strings.Builder
+	writeHeader(&unix, "linux darwin")
+	writeHeader(&windows, "windows")
+	for _, variant := range variants {
+		data := withStatusDot(base, variant.Color)
+		writeArray(&unix, variant.Name, data)
+		writeArray(&windows, variant.Name, pngToIco(data, base.Bounds()))
+	}
+	write("statusunix.go", unix.String())
+	write("statuswin.go", windows.String())
+}
+
+// withStatusDot draws a filled circle with a white border in the bottom right corner
+func withStatusDot(base image.Image, dot color.RGBA) []byte {
+	bounds := base.Bounds()
+	img := image.NewRGBA(bounds)
+	draw.Draw(img, bounds, base, bounds.Min, draw.Src)
+
+	radius := bounds.Dx() / 4
+	centerX := bounds.Max.X - radius - 1
+	centerY := bounds.Max.Y - radius - 1
+	for y := centerY - radius; y <= centerY+radius; y++ {
+		for x := centerX - radius; x <= centerX+radius; x++ {
+			distance := (x-centerX)*(x-centerX) + (y-centerY)*(y-centerY)
+			switch {
+			case distance <= (radius-1)*(radius-1):
+				img.Set(x, y, dot)
+			case distance <= radius*radius:
+				img.Set(x, y, color.White)
+			}
+		}
+	}
+
+	var out bytes.Buffer
+	png.Encode(&out, img)
+	return out.Bytes()
+}
+
+// pngToIco wraps the PNG into a single image ICO file
+func pngToIco(data []byte, bounds image.Rectangle) []byte {
+	var out bytes.Buffer
+	binary.Write(&out, binary.LittleEndian, []uint16{0, 1, 1})
+	out.WriteByte(byte(bounds.Dx() % 256))
+	out.WriteByte(byte(bounds.Dy() % 256))
+	out.WriteByte(0)
+	out.WriteByte(0)
+	binary.Write(&out, binary.LittleEndian, []uint16{1, 32})
+	binary.Write(&out, binary.LittleEndian, []uint32{uint32(len(data)), 22})
+	out.Write(data)
+	return out.Bytes()
+}
+
+func writeHeader(out *strings.Builder, buildTag string) {
+	fmt.Fprintf(out, "//go:build %s\n", strings.ReplaceAll(buildTag, " ", " || "))
+	fmt.Fprintf(out, "// +build %s\n\n", buildTag)
+	fmt.Fprintf(out, "// File generated by generate/main.go, DO NOT EDIT\n\n")
+	fmt.Fprintf(out, "package icon\n")
+}
+
+func writeArray(out *strings.Builder, name string, data []byte) {
+	fmt.Fprintf(out, "\nvar %s []byte = []byte{", name)
+	for i, b := range data {
+		if i%12 == 0 {
+			fmt.Fprintf(out, "\n\t")
+		} else {
+			fmt.Fprintf(out, " ")
+		}
+		fmt.Fprintf(out, "0x%02x,", b)
+	}
+	fmt.Fprintf(out, "\n}\n")
+}
+
+func write(path string, content string) {
+	fmt.Printf("Generating %s\n", path)
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+}
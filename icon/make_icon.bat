@@ -9,6 +9,22 @@ ECHO Creating iconwin.go
 ECHO //+build windows > iconwin.go
 ECHO. >> iconwin.go
 TYPE %1 | %GOPATH%\bin\2goarray Data icon >> iconwin.go
+REM The status icons are drawn on the PNG of the icon, icon.png unless given
+SET PNG=%2
+IF "%PNG%"=="" SET PNG=icon.png
+IF NOT EXIST %PNG% GOTO BADPNG
+ECHO Generating status icons
+go run generate/main.go %PNG%
+IF ERRORLEVEL 1 GOTO STATUSFAIL
+ECHO Finished
+GOTO DONE
+
+:STATUSFAIL
+ECHO Failure generating status icons
+GOTO DONE
+
+:BADPNG
+ECHO %PNG% is not a valid file
 GOTO DONE
 
 :CREATEFAIL
@@ -30,7 +46,7 @@ ECHO GOPATH environment variable not set
 GOTO DONE
 
 :NOICO
-ECHO Please specify a .ico file
+ECHO Please specify a .ico file, optionally followed by the PNG file of the status icons
 GOTO DONE
 
 :BADFILE
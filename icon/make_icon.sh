@@ -33,4 +33,10 @@ if [ $? -ne 0 ]; then
     echo Failure generating $OUTPUT
     exit
 fi
+echo Generating status icons
+go run generate/main.go "$1"
+if [ $? -ne 0 ]; then
+    echo Failure generating status icons
+    exit
+fi
 echo Finished
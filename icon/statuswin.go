@@ -0,0 +1,561 @@
+//go:build windows
+// +build windows
+
+// File generated by generate/main.go, DO NOT EDIT
+
+package icon
+
+var Healthy []byte = []byte{
+	0x00, 0x00, 0x01, 0x00, 0x01, 0x00, 0x20, 0x1f, 0x00, 0x00, 0x01, 0x00,
+	0x20, 0x00, 0x45, 0x06, 0x00, 0x00, 0x16, 0x00, 0x00, 0x00, 0x89, 0x50,
+	0x4e, 0x47, 0x0d, 0x0a, 0x1a, 0x0a, 0x00, 0x00, 0x00, 0x0d, 0x49, 0x48,
+	0x44, 0x52, 0x00, 0x00, 0x00, 0x20, 0x00, 0x00, 0x00, 0x1f, 0x08, 0x06,
+	0x00, 0x00, 0x00, 0x86, 0x56, 0xcf, 0x8c, 0x00, 0x00, 0x06, 0x0c, 0x49,
+	0x44, 0x41, 0x54, 0x78, 0x9c, 0xac, 0x56, 0x0b, 0x6c, 0x54, 0x4d, 0x15,
+	0xfe, 0x66, 0xee, 0xdd, 0x67, 0xbb, 0x6d, 0xb7, 0x5b, 0xfa, 0xe0, 0xd1,
+	0xdf, 0xfa, 0xdb, 0xbf, 0xd0, 0xbf, 0x4f, 0xf9, 0xfd, 0x81, 0xee, 0xff,
+	0x48, 0xa0, 0x26, 0x06, 0x85, 0xf0, 0x32, 0x4a, 0x34, 0xa9, 0x48, 0x88,
+	0x16, 0xb6, 0xa4, 0xa8, 0x80, 0x5a, 0x4d, 0x34, 0x60, 0x40, 0x45, 0xa8,
+	0xad, 0x20, 0xd4, 0xa0, 0x40, 0x08, 0x90, 0x62, 0x54, 0x22, 0x89, 0x62,
+	0x40, 0x14, 0x90, 0x80, 0x52, 0xb5, 0x34, 0x56, 0x1e, 0xd2, 0x86, 0xc7,
+	0x76, 0x4b, 0x4b, 0x4b, 0x97, 0x3e, 0xe8, 0xee, 0xbd, 0x3b, 0x63, 0x66,
+	0x64, 0xb6, 0xdb, 0xed, 0xc3, 0xb6, 0xfa, 0x9d, 0xbb, 0xb9, 0x77, 0xce,
+	0x39, 0x77, 0xcf, 0x37, 0x33, 0xe7, 0x9c, 0xb9, 0x3a, 0x66, 0x80, 0x37,
+	0x37, 0x06, 0x16, 0x58, 0x9d, 0xe4, 0xab, 0x9c, 0xb3, 0x55, 0x00, 0x09,
+	0x72, 0x86, 0x23, 0x7d, 0x83, 0xc3, 0x07, 0x1f, 0x1e, 0xcf, 0xe9, 0x53,
+	0x3e, 0x53, 0x05, 0x51, 0x0f, 0x53, 0x41, 0xfe, 0xe6, 0xae, 0x12, 0x0b,
+	0x31, 0x6b, 0x28, 0xc1, 0x1a, 0x42, 0x40, 0x95, 0x5e, 0x08, 0xe7, 0x08,
+	0x32, 0xc6, 0x0f, 0xf5, 0xb3, 0xe1, 0xda, 0xb6, 0xc3, 0xaf, 0x77, 0x29,
+	0xfd, 0xff, 0x85, 0x40, 0xc1, 0x16, 0xff, 0x12, 0x9d, 0x92, 0x1a, 0xc2,
+	0xf9, 0x72, 0x42, 0xc8, 0xa4, 0xef, 0x70, 0x60, 0x88, 0x71, 0xf2, 0x13,
+	0x46, 0xac, 0xfb, 0x5a, 0xea, 0x3d, 0x4f, 0x94, 0x7e, 0x46, 0x04, 0x8a,
+	0x7c, 0x9d, 0x4b, 0x29, 0x61, 0x35, 0x14, 0x58, 0xaa, 0x74, 0xb1, 0x78,
+	0x2d, 0x5d, 0x87, 0x11, 0xe1, 0xe8, 0xe8, 0x89, 0x28, 0xd5, 0x08, 0x18,
+	0xc2, 0x11, 0x90, 0x13, 0x86, 0x55, 0xfb, 0x6e, 0xeb, 0x81, 0xf4, 0x7f,
+	0x29, 0xf5, 0x14, 0x08, 0x70, 0x52, 0x5c, 0xf9, 0x74, 0x39, 0xd5, 0x78,
+	0x0d, 0x21, 0x7c, 0x89, 0xd2, 0x8e, 0x87, 0x9f, 0x56, 0x7b, 0xd0, 0xd1,
+	0x1b, 0xc1, 0x37, 0x4e, 0x4c, 0xbc, 0xf5, 0x0c, 0x88, 0x10, 0x90, 0x46,
+	0x33, 0x44, 0xf7, 0xb4, 0x34, 0x64, 0xb4, 0x28, 0xbd, 0xc2, 0xa8, 0x24,
+	0x2c, 0xac, 0xf4, 0x97, 0x6b, 0x5a, 0xe0, 0xfb, 0x94, 0xa0, 0x44, 0xe9,
+	0x26, 0x43, 0x57, 0x90, 0xa1, 0x2b, 0x18, 0x99, 0xd0, 0x2e, 0x84, 0x02,
+	0x1a, 0xc0, 0xd7, 0xeb, 0x16, 0xf3, 0xd3, 0xa5, 0xbe, 0xc0, 0xaf, 0x29,
+	0xf8, 0xce, 0xa6, 0x1f, 0xcd, 0xbe, 0x13, 0x63, 0x17, 0x37, 0x20, 0x77,
+	0x5b, 0xcf, 0x1c, 0x5d, 0xa3, 0xe7, 0xa7, 0x1a, 0x5c, 0x81, 0x31, 0xf5,
+	0x34, 0x39, 0x08, 0x15, 0xd9, 0xc3, 0x57, 0x32, 0x8e, 0xab, 0x85, 0x95,
+	0x7d, 0xee, 0x31, 0x2b, 0x90, 0x18, 0x0a, 0x2f, 0x23, 0x1a, 0xb7, 0xa9,
+	0xf1, 0x44, 0xc8, 0xc9, 0xd0, 0x51, 0x5e, 0x6a, 0x47, 0x7e, 0xb6, 0x05,
+	0x6f, 0xbf, 0x61, 0x03, 0xe3, 0x36, 0xf9, 0xdc, 0x74, 0x3f, 0x8c, 0xdf,
+	0xdc, 0x7a, 0x29, 0xb7, 0x44, 0xf9, 0x8e, 0x07, 0x42, 0x91, 0x46, 0x31,
+	0xf8, 0x51, 0x00, 0x8d, 0xa3, 0x08, 0x70, 0xca, 0xbc, 0x24, 0x26, 0x25,
+	0xbe, 0xf5, 0x99, 0x14, 0xdc, 0xf3, 0x1b, 0x38, 0xf5, 0x87, 0x41, 0x39,
+	0x4e, 0x4b, 0xa2, 0xd8, 0xbe, 0x2e, 0x19, 0xcb, 0x8a, 0xed, 0x88, 0xaf,
+	0x83, 0x45, 0x79, 0x36, 0xf9, 0xfb, 0xc2, 0x72, 0x17, 0xce, 0xdd, 0x18,
+	0xc2, 0x0f, 0xcf, 0xf5, 0x63, 0xe0, 0x25, 0x93, 0x7e, 0x3b, 0xd6, 0x25,
+	0x23, 0x64, 0x70, 0xd4, 0xfe, 0xea, 0x85, 0x72, 0x07, 0x28, 0xf5, 0x8e,
+	0x21, 0x00, 0x4e, 0xca, 0x62, 0x53, 0xf2, 0x7e, 0x87, 0x81, 0xcd, 0x9f,
+	0x70, 0xc1, 0x61, 0x23, 0xf8, 0xfb, 0x83, 0x30, 0xf6, 0x7c, 0xce, 0x0d,
+	0x4f, 0x12, 0x45, 0xe7, 0xf3, 0x08, 0xce, 0x5e, 0x1d, 0xc2, 0x8d, 0x3b,
+	0x21, 0x9c, 0xdc, 0x91, 0x86, 0xb6, 0x80, 0x89, 0xbd, 0x67, 0x83, 0x78,
+	0xf7, 0x4d, 0x3b, 0x56, 0x97, 0x39, 0xb0, 0xa6, 0xcc, 0x89, 0x92, 0x0f,
+	0x5a, 0xb1, 0xeb, 0x74, 0x10, 0x9f, 0x7c, 0xc7, 0x89, 0x77, 0x0b, 0x6c,
+	0xd8, 0xd3, 0x18, 0x13, 0x1c, 0x80, 0xc6, 0xb8, 0x57, 0x3d, 0xcb, 0x90,
+	0xaf, 0x55, 0xb4, 0xa7, 0xa4, 0xba, 0x6c, 0x3d, 0xf1, 0xcd, 0x65, 0xb6,
+	0x47, 0xc3, 0xa1, 0x2d, 0x1e, 0xcc, 0x4b, 0xd3, 0xc4, 0x10, 0x67, 0xae,
+	0x0c, 0xa2, 0xee, 0x5c, 0xbf, 0x9c, 0x51, 0x82, 0x9d, 0xe0, 0xca, 0xf7,
+	0x32, 0xf1, 0xb8, 0xdb, 0xc4, 0xaa, 0x5d, 0xdd, 0xc2, 0x0c, 0x77, 0x22,
+	0xc5, 0xb7, 0x3f, 0x9b, 0x02, 0x6f, 0xfe, 0x7f, 0x76, 0xf2, 0xee, 0x13,
+	0x03, 0x55, 0x87, 0x7b, 0xd1, 0xf3, 0x62, 0x74, 0xa2, 0x30, 0x4e, 0x4c,
+	0x3f, 0xd3, 0xdc, 0xdd, 0x87, 0xd2, 0x07, 0x64, 0x12, 0x26, 0x27, 0xd8,
+	0x17, 0xc7, 0x07, 0x17, 0x22, 0xea, 0xbb, 0x2d, 0x60, 0x44, 0x93, 0xad,
+	0xbb, 0x8f, 0xc1, 0x30, 0xb9, 0x18, 0xc2, 0x69, 0x93, 0xaf, 0x22, 0xd1,
+	0x31, 0xf2, 0x9a, 0x20, 0xd6, 0x3b, 0x30, 0x12, 0xec, 0x6f, 0x0f, 0xc2,
+	0x63, 0x82, 0x8b, 0x8b, 0x12, 0xae, 0x67, 0x72, 0x63, 0x71, 0xb4, 0x0a,
+	0x34, 0xca, 0xcb, 0x94, 0x31, 0x16, 0xf3, 0xe7, 0x59, 0xf0, 0x7e, 0xa1,
+	0x1d, 0x0f, 0x02, 0x26, 0xfa, 0x06, 0x19, 0xaa, 0x56, 0xba, 0xf0, 0x63,
+	0x9f, 0x07, 0x19, 0x6e, 0x4d, 0xae, 0x80, 0xf0, 0x49, 0x7c, 0x75, 0x2f,
+	0xfc, 0x80, 0x05, 0xa7, 0x76, 0xce, 0xc2, 0x8a, 0xb7, 0x1d, 0x68, 0xeb,
+	0x34, 0xd1, 0xd3, 0xcf, 0xb0, 0xba, 0xcc, 0x89, 0x54, 0xd7, 0x98, 0x79,
+	0xc9, 0x4b, 0x23, 0xf0, 0x46, 0x73, 0x80, 0xc8, 0xfd, 0xe7, 0xf1, 0x3e,
+	0xf2, 0xcf, 0xc4, 0xfd, 0xe0, 0xf9, 0x7e, 0xb9, 0xd7, 0xf5, 0x95, 0xa9,
+	0x78, 0x2b, 0xd7, 0x8a, 0x33, 0x3b, 0xd3, 0xa2, 0xd9, 0x6e, 0xd1, 0x09,
+	0x8e, 0x7d, 0x29, 0x4d, 0x56, 0x82, 0x46, 0x81, 0xdb, 0xed, 0x61, 0x54,
+	0x37, 0x3c, 0x97, 0xb9, 0xe0, 0x5b, 0xe1, 0xc2, 0xc7, 0x16, 0x3a, 0xa2,
+	0x89, 0x1c, 0x2b, 0x44, 0xc3, 0x3b, 0xe2, 0x4e, 0xf1, 0xfe, 0x65, 0x9d,
+	0x53, 0xbe, 0x48, 0x19, 0x62, 0x51, 0xfa, 0xba, 0x15, 0xc3, 0x61, 0x8e,
+	0xeb, 0xad, 0x21, 0x3c, 0x7e, 0x66, 0xe2, 0xf3, 0xb5, 0xcf, 0xd0, 0xfa,
+	0xc8, 0x40, 0x92, 0x93, 0x62, 0xfe, 0x5c, 0x8b, 0x72, 0x93, 0xb3, 0x17,
+	0xc1, 0xaf, 0xfd, 0x23, 0x84, 0xca, 0x83, 0xbd, 0x08, 0x0e, 0x32, 0x5c,
+	0x6a, 0x1e, 0x96, 0x36, 0x41, 0x78, 0x3c, 0x70, 0x4e, 0x17, 0x01, 0x97,
+	0x75, 0xfd, 0xad, 0xa2, 0x82, 0x42, 0x06, 0x23, 0x51, 0x19, 0x62, 0x91,
+	0x95, 0xaa, 0xa1, 0xfd, 0xa9, 0x29, 0xfb, 0xbd, 0x18, 0xf7, 0xf6, 0x33,
+	0xfc, 0xe2, 0xfa, 0x10, 0xf2, 0xb3, 0x93, 0xf1, 0x7c, 0x80, 0x61, 0xc3,
+	0xfe, 0x1e, 0x24, 0x27, 0x10, 0x34, 0x6c, 0xf5, 0xc0, 0x66, 0x21, 0x38,
+	0xfa, 0xbb, 0x01, 0xfc, 0x69, 0x5f, 0xa6, 0x7a, 0x1d, 0xc5, 0xc7, 0xcb,
+	0xd1, 0x5c, 0x71, 0x11, 0x4d, 0x75, 0x59, 0x72, 0xbc, 0x70, 0x6b, 0x40,
+	0x99, 0x40, 0x09, 0x77, 0x15, 0x6d, 0xc9, 0x2b, 0xd2, 0x8d, 0x48, 0xd8,
+	0xab, 0x69, 0x71, 0x85, 0x1d, 0x23, 0x66, 0x84, 0xe3, 0xbd, 0x02, 0xbb,
+	0xcc, 0x6c, 0xf1, 0x13, 0xa4, 0x14, 0x19, 0xb1, 0x2a, 0x1d, 0xbd, 0x80,
+	0x19, 0x01, 0x6c, 0x16, 0xe0, 0x67, 0xdb, 0x3c, 0x32, 0xa8, 0x7a, 0x57,
+	0x91, 0x50, 0x68, 0xae, 0xbb, 0x38, 0x8a, 0x88, 0x46, 0xa8, 0x97, 0x94,
+	0x56, 0xf9, 0x4f, 0x11, 0x90, 0xf5, 0xca, 0x49, 0x2c, 0x65, 0x7a, 0x8a,
+	0x26, 0x97, 0xff, 0xeb, 0x9f, 0x4a, 0x86, 0xc3, 0x3a, 0x31, 0x39, 0xd1,
+	0xfd, 0x12, 0x1d, 0x04, 0x79, 0x73, 0x2d, 0x63, 0x82, 0x4d, 0x24, 0xcd,
+	0x15, 0x23, 0x24, 0x18, 0x43, 0xa3, 0x4e, 0x80, 0x68, 0x05, 0x88, 0x3a,
+	0xfe, 0xe5, 0x37, 0x67, 0xc1, 0xe5, 0xa0, 0x78, 0xd4, 0x6d, 0xca, 0xd9,
+	0x8b, 0x03, 0xf3, 0xf7, 0xcd, 0xc3, 0xb8, 0x79, 0x37, 0x24, 0xab, 0x41,
+	0xfc, 0xbe, 0xb2, 0x36, 0x09, 0x1f, 0xff, 0x88, 0x03, 0x0b, 0x73, 0xad,
+	0xe3, 0xce, 0x74, 0x32, 0x51, 0xdb, 0xf2, 0xaa, 0x09, 0x79, 0x75, 0xce,
+	0x69, 0x06, 0x21, 0x22, 0x10, 0x64, 0xf2, 0x88, 0xae, 0xd5, 0xfa, 0xd0,
+	0x90, 0x49, 0x27, 0x12, 0xe8, 0x48, 0x95, 0x47, 0xd6, 0xf2, 0xcf, 0xaf,
+	0x0d, 0x09, 0x17, 0xd9, 0x5e, 0x45, 0xd2, 0x89, 0x67, 0xd1, 0x0d, 0x17,
+	0xcf, 0xb7, 0x4d, 0x39, 0xb8, 0x12, 0xe1, 0x2f, 0xb6, 0xa3, 0xb4, 0xaa,
+	0x33, 0x95, 0x72, 0xc2, 0xcf, 0x28, 0x03, 0xe3, 0xc0, 0x85, 0xa6, 0x97,
+	0x32, 0xb8, 0x18, 0xdf, 0xba, 0x1f, 0x46, 0x73, 0x7b, 0x18, 0x6b, 0xbc,
+	0x4e, 0x14, 0xe7, 0x58, 0xa3, 0x7d, 0x3f, 0x7b, 0x96, 0x8e, 0x17, 0x43,
+	0x0c, 0x5f, 0x3b, 0x36, 0xed, 0x4f, 0xc0, 0xd1, 0x42, 0x70, 0x92, 0x06,
+	0xfa, 0xa8, 0x0f, 0x0c, 0xb7, 0x95, 0x2e, 0x1e, 0xbb, 0x4f, 0x07, 0x11,
+	0x36, 0x38, 0x7e, 0xb0, 0xc9, 0x8d, 0x05, 0xf3, 0x2c, 0xb2, 0xbf, 0x0b,
+	0xfd, 0xd1, 0x0b, 0x03, 0x92, 0x84, 0xf2, 0x9b, 0x09, 0xb4, 0x10, 0xaf,
+	0x96, 0x19, 0xf6, 0x86, 0xaf, 0x33, 0xc7, 0x49, 0xf8, 0x5f, 0x28, 0xb8,
+	0x47, 0x19, 0x63, 0xb1, 0x64, 0x81, 0x0d, 0xfb, 0x36, 0xba, 0x41, 0xc5,
+	0xd7, 0x05, 0x25, 0xf2, 0x40, 0x5a, 0xfb, 0x9d, 0x6e, 0xdc, 0xd8, 0x9f,
+	0x39, 0xed, 0xe5, 0x8f, 0x15, 0x91, 0x0b, 0xd1, 0x14, 0x2f, 0xdc, 0xea,
+	0x5f, 0xa6, 0x31, 0xf2, 0x5b, 0x4a, 0x62, 0x4e, 0xc8, 0x18, 0xc9, 0x9b,
+	0x63, 0xc1, 0xde, 0x0d, 0x29, 0xc8, 0x4e, 0xd7, 0x11, 0x61, 0x90, 0x47,
+	0xb5, 0xbf, 0x27, 0x82, 0xf2, 0x12, 0xfb, 0x8c, 0x48, 0xc8, 0xe0, 0x84,
+	0x90, 0x68, 0xa3, 0x6e, 0xa9, 0x9b, 0x73, 0x89, 0x12, 0xb6, 0x5d, 0x8d,
+	0xe3, 0x71, 0xd7, 0x6f, 0xe0, 0x9e, 0xdf, 0xc4, 0x3f, 0x1f, 0x1b, 0xf8,
+	0x63, 0xcb, 0xb0, 0x2c, 0x55, 0x11, 0xfc, 0x7f, 0xc5, 0xa8, 0xd9, 0xfe,
+	0xb5, 0x7e, 0x6e, 0x6d, 0xb1, 0x2f, 0x50, 0xa2, 0x11, 0x5e, 0xa1, 0x74,
+	0x0a, 0xb9, 0xb3, 0x2d, 0x78, 0xaf, 0xd0, 0x86, 0xb5, 0xbb, 0xbb, 0xa3,
+	0xe7, 0x80, 0xae, 0x11, 0xdc, 0x3c, 0x90, 0x19, 0xef, 0x3a, 0x2d, 0x19,
+	0x73, 0x54, 0xf5, 0xb5, 0x0c, 0x7f, 0x91, 0x81, 0xfc, 0x59, 0x8d, 0x15,
+	0x7c, 0x2b, 0x5c, 0xf2, 0x78, 0x55, 0xc1, 0x55, 0x97, 0x14, 0x0d, 0x45,
+	0xd5, 0xf5, 0x74, 0x97, 0x3f, 0xfa, 0x41, 0x12, 0x2f, 0xe2, 0x03, 0x35,
+	0xc1, 0x0c, 0xdd, 0xa2, 0x40, 0x74, 0x7a, 0x1f, 0xca, 0xd2, 0xe5, 0x91,
+	0xfc, 0x6c, 0x9c, 0xf3, 0x5d, 0xf5, 0xfa, 0xa9, 0xe4, 0x42, 0xb4, 0x09,
+	0x4d, 0x46, 0x40, 0x48, 0x41, 0xe5, 0xd3, 0x32, 0x2b, 0x8d, 0x5c, 0x06,
+	0xc5, 0xf8, 0xc7, 0xd9, 0x0c, 0x48, 0xc4, 0x07, 0x9f, 0x94, 0x80, 0xb8,
+	0x4a, 0x36, 0x77, 0x6c, 0xa2, 0x1a, 0x1a, 0xd4, 0x78, 0x3a, 0x44, 0x14,
+	0x19, 0x15, 0x34, 0x3e, 0xf0, 0x94, 0x08, 0x88, 0xeb, 0xc3, 0x55, 0x4f,
+	0xaa, 0x39, 0xd7, 0xbe, 0x4c, 0xc0, 0xdc, 0x71, 0xa6, 0xff, 0x02, 0x62,
+	0x34, 0xd5, 0x67, 0xa5, 0x8c, 0x17, 0x34, 0x16, 0xff, 0x1e, 0x00, 0xf4,
+	0xd6, 0x56, 0xd9, 0xb1, 0x18, 0x25, 0x60, 0x00, 0x00, 0x00, 0x00, 0x49,
+	0x45, 0x4e, 0x44, 0xae, 0x42, 0x60, 0x82,
+}
+
+var Degraded []byte = []byte{
+	0x00, 0x00, 0x01, 0x00, 0x01, 0x00, 0x20, 0x1f, 0x00, 0x00, 0x01, 0x00,
+	0x20, 0x00, 0x43, 0x06, 0x00, 0x00, 0x16, 0x00, 0x00, 0x00, 0x89, 0x50,
+	0x4e, 0x47, 0x0d, 0x0a, 0x1a, 0x0a, 0x00, 0x00, 0x00, 0x0d, 0x49, 0x48,
+	0x44, 0x52, 0x00, 0x00, 0x00, 0x20, 0x00, 0x00, 0x00, 0x1f, 0x08, 0x06,
+	0x00, 0x00, 0x00, 0x86, 0x56, 0xcf, 0x8c, 0x00, 0x00, 0x06, 0x0a, 0x49,
+	0x44, 0x41, 0x54, 0x78, 0x9c, 0xac, 0x56, 0x0b, 0x6c, 0x54, 0x4d, 0x15,
+	0xfe, 0x66, 0xee, 0xdd, 0x67, 0xbb, 0x6d, 0xb7, 0x5b, 0xfa, 0xe0, 0xd1,
+	0xdf, 0xfa, 0xdb, 0xbf, 0x50, 0xfa, 0x14, 0xe4, 0xd1, 0xe5, 0x91, 0x00,
+	0x26, 0x06, 0x85, 0xf0, 0x32, 0x4a, 0x34, 0xa9, 0x48, 0x88, 0x16, 0xb6,
+	0xa4, 0xa8, 0x80, 0x5a, 0x4d, 0x34, 0x60, 0x40, 0x45, 0xa8, 0xad, 0x20,
+	0xd4, 0xa0, 0x40, 0x08, 0x90, 0x62, 0x54, 0x22, 0x89, 0x62, 0x82, 0x28,
+	0x20, 0x01, 0xa5, 0x2a, 0x34, 0x56, 0x1e, 0xd2, 0x86, 0xc7, 0x76, 0x4b,
+	0x4b, 0x4b, 0x97, 0x3e, 0xe8, 0xee, 0xbd, 0x3b, 0x63, 0x66, 0x74, 0xb6,
+	0xdb, 0xed, 0xc3, 0xb6, 0xfe, 0xdf, 0xb9, 0x9b, 0x7b, 0xe7, 0x9c, 0x73,
+	0xf7, 0x7c, 0x33, 0x73, 0xce, 0x99, 0xab, 0x63, 0x0a, 0x98, 0xbb, 0x35,
+	0x30, 0xc7, 0xea, 0x24, 0x5f, 0xe5, 0x9c, 0xad, 0x03, 0x48, 0x90, 0x33,
+	0x9c, 0xe8, 0xe9, 0x1f, 0x3c, 0xfa, 0xf4, 0x74, 0x4e, 0x8f, 0xf2, 0x99,
+	0x28, 0x88, 0x7a, 0x98, 0x08, 0xf2, 0xb7, 0x77, 0x94, 0x58, 0x88, 0x59,
+	0x4d, 0x09, 0x36, 0x10, 0x02, 0xaa, 0xf4, 0x42, 0x38, 0x47, 0x90, 0x31,
+	0x7e, 0xac, 0x97, 0x0d, 0xd6, 0xb4, 0x1c, 0x7f, 0xb7, 0x43, 0xe9, 0xdf,
+	0x17, 0x02, 0x05, 0x3b, 0xfc, 0x8b, 0x75, 0x4a, 0xaa, 0x09, 0xe7, 0xab,
+	0x09, 0x21, 0xe3, 0xbe, 0xc3, 0x81, 0x01, 0xc6, 0xc9, 0x4f, 0x18, 0xb1,
+	0x1e, 0x6a, 0xaa, 0xf3, 0xbc, 0x50, 0xfa, 0x29, 0x11, 0x28, 0xf2, 0xb5,
+	0xaf, 0xa0, 0x84, 0x55, 0x53, 0x60, 0x85, 0xd2, 0xc5, 0xe2, 0x9d, 0x74,
+	0x1d, 0x46, 0x84, 0xa3, 0xad, 0x2b, 0xa2, 0x54, 0x43, 0x60, 0x08, 0x47,
+	0x40, 0xce, 0x18, 0x56, 0xed, 0xbb, 0xcd, 0x47, 0xd2, 0xff, 0xa5, 0xd4,
+	0x13, 0x20, 0xc0, 0x49, 0x71, 0xc5, 0xcb, 0xd5, 0x54, 0xe3, 0xd5, 0x84,
+	0xf0, 0xc5, 0x4a, 0x3b, 0x1a, 0x7e, 0x5a, 0xe5, 0x41, 0x5b, 0x77, 0x04,
+	0xdf, 0x38, 0x33, 0xf6, 0xd6, 0x33, 0x20, 0x42, 0x40, 0x1a, 0xcc, 0x10,
+	0x3d, 0xd0, 0x54, 0x9f, 0xd1, 0xa4, 0xf4, 0x0a, 0xc3, 0x92, 0xb0, 0xb0,
+	0xc2, 0xbf, 0x4a, 0xd3, 0x02, 0xdf, 0xa7, 0x04, 0x25, 0x4a, 0x37, 0x1e,
+	0x3a, 0x82, 0x0c, 0x1d, 0xc1, 0xc8, 0x98, 0x76, 0x21, 0x14, 0xd0, 0x00,
+	0xbe, 0x59, 0xb7, 0x98, 0x9f, 0x2e, 0xf5, 0x05, 0x7e, 0x4d, 0xc1, 0xf7,
+	0x36, 0xfe, 0x68, 0xfa, 0x83, 0x18, 0xbb, 0xb8, 0x01, 0xb9, 0xbb, 0xba,
+	0x66, 0xe8, 0x1a, 0xbd, 0x3c, 0xd1, 0xe0, 0x0a, 0x8c, 0xa9, 0xa7, 0xf1,
+	0x41, 0xa8, 0xc8, 0x1e, 0xbe, 0x96, 0x71, 0xdc, 0x28, 0xac, 0xe8, 0x71,
+	0x8f, 0x58, 0x81, 0xc4, 0x50, 0x78, 0x25, 0xd1, 0xb8, 0x4d, 0x8d, 0xc7,
+	0x42, 0x4e, 0x86, 0x8e, 0x55, 0xa5, 0x76, 0xe4, 0x67, 0x5b, 0xb0, 0xe0,
+	0x3d, 0x1b, 0x18, 0xb7, 0xc9, 0xe7, 0xc6, 0xc7, 0x61, 0xfc, 0xe6, 0xee,
+	0x5b, 0xb9, 0x25, 0xca, 0x77, 0x34, 0x10, 0x8a, 0x34, 0x8a, 0xfe, 0x8f,
+	0x02, 0x68, 0x18, 0x46, 0x80, 0x53, 0xe6, 0x25, 0x31, 0x29, 0xf1, 0xad,
+	0xcf, 0xa4, 0xe0, 0x91, 0xdf, 0xc0, 0xb9, 0x3f, 0xf4, 0xcb, 0x71, 0x5a,
+	0x12, 0xc5, 0xee, 0x4d, 0xc9, 0x58, 0x59, 0x6c, 0x47, 0x7c, 0x1d, 0x2c,
+	0xcc, 0xb3, 0xc9, 0xdf, 0x17, 0x56, 0xbb, 0x70, 0xe9, 0xf6, 0x00, 0x7e,
+	0x78, 0xa9, 0x17, 0x7d, 0x6f, 0x99, 0xf4, 0xdb, 0xb3, 0x29, 0x19, 0x21,
+	0x83, 0xa3, 0xe6, 0x57, 0x6f, 0x94, 0x3b, 0x40, 0xa9, 0x77, 0x04, 0x01,
+	0x70, 0x52, 0x16, 0x9b, 0x92, 0x8f, 0xdb, 0x0c, 0x6c, 0xff, 0x84, 0x0b,
+	0x0e, 0x1b, 0xc1, 0xdf, 0x9f, 0x84, 0x71, 0xe0, 0x73, 0x6e, 0x78, 0x92,
+	0x28, 0xda, 0x5f, 0x47, 0x70, 0xf1, 0xc6, 0x00, 0x6e, 0x3f, 0x08, 0xe1,
+	0xec, 0x9e, 0x34, 0xb4, 0x04, 0x4c, 0x1c, 0xbc, 0x18, 0xc4, 0xd2, 0xb9,
+	0x76, 0xac, 0x2f, 0x73, 0x60, 0x43, 0x99, 0x13, 0x25, 0x1f, 0xb4, 0x62,
+	0xdf, 0xf9, 0x20, 0x3e, 0xb9, 0xc4, 0x89, 0xa5, 0x05, 0x36, 0x1c, 0x68,
+	0x88, 0x09, 0x0e, 0x40, 0x63, 0xdc, 0xab, 0x9e, 0x65, 0xc8, 0x77, 0xca,
+	0x5b, 0x53, 0x52, 0x5d, 0xb6, 0xae, 0xf8, 0xe6, 0x32, 0xdd, 0xa3, 0xe1,
+	0xd8, 0x0e, 0x0f, 0x66, 0xa5, 0x69, 0x62, 0x88, 0x0b, 0xd7, 0xfb, 0x51,
+	0x7b, 0xa9, 0x57, 0xce, 0x28, 0xc1, 0x4e, 0x70, 0xfd, 0x7b, 0x99, 0x78,
+	0xde, 0x69, 0x62, 0xdd, 0xbe, 0x4e, 0x61, 0x86, 0x3b, 0x91, 0xe2, 0xdb,
+	0x9f, 0x4d, 0x81, 0x37, 0xff, 0x3f, 0x3b, 0xf9, 0xf0, 0x85, 0x81, 0xca,
+	0xe3, 0xdd, 0xe8, 0x7a, 0x33, 0x3c, 0x51, 0x18, 0x27, 0xa6, 0x9f, 0x69,
+	0xee, 0xce, 0x63, 0xe9, 0x7d, 0x32, 0x09, 0x93, 0x13, 0xec, 0x8b, 0xe2,
+	0x83, 0x0b, 0x11, 0xf5, 0xdd, 0x12, 0x30, 0xa2, 0xc9, 0xd6, 0xd9, 0xc3,
+	0x60, 0x98, 0x5c, 0x0c, 0xe1, 0xb4, 0xc9, 0x57, 0x91, 0xe8, 0x18, 0x7a,
+	0x4d, 0x10, 0xeb, 0xee, 0x1b, 0x0a, 0xf6, 0xb7, 0x27, 0xe1, 0x11, 0xc1,
+	0xc5, 0x45, 0x09, 0xd7, 0x33, 0xb9, 0xb1, 0x28, 0x5a, 0x05, 0x1a, 0xe5,
+	0x65, 0xca, 0x18, 0x8b, 0xd9, 0xb3, 0x2c, 0x58, 0x5e, 0x68, 0xc7, 0x93,
+	0x80, 0x89, 0x9e, 0x7e, 0x86, 0xca, 0xb5, 0x2e, 0xfc, 0xd8, 0xe7, 0x41,
+	0x86, 0x5b, 0x93, 0x2b, 0x20, 0x7c, 0x12, 0xff, 0x7b, 0x2f, 0xfc, 0x80,
+	0x05, 0xe7, 0xf6, 0x4e, 0xc3, 0x9a, 0x05, 0x0e, 0xb4, 0xb4, 0x9b, 0xe8,
+	0xea, 0x65, 0x58, 0x5f, 0xe6, 0x44, 0xaa, 0x6b, 0xc4, 0xbc, 0xe4, 0xa5,
+	0x11, 0x78, 0xa3, 0x39, 0x40, 0xe4, 0xfe, 0xf3, 0x78, 0x1f, 0xf9, 0x67,
+	0xe2, 0x7e, 0xf4, 0x72, 0xaf, 0xdc, 0xeb, 0xba, 0x8a, 0x54, 0xcc, 0xcf,
+	0xb5, 0xe2, 0xc2, 0xde, 0xb4, 0x68, 0xb6, 0x5b, 0x74, 0x82, 0x53, 0x5f,
+	0x4a, 0x93, 0x95, 0xa0, 0x51, 0xe0, 0x7e, 0x6b, 0x18, 0x55, 0xf5, 0xaf,
+	0x65, 0x2e, 0xf8, 0xd6, 0xb8, 0xf0, 0xb1, 0x79, 0x8e, 0x68, 0x22, 0xc7,
+	0x0a, 0xd1, 0xb0, 0x44, 0xdc, 0x29, 0x96, 0x5f, 0xd3, 0x39, 0xe5, 0x0b,
+	0x95, 0x21, 0x16, 0xa5, 0xef, 0x5a, 0x31, 0x18, 0xe6, 0xb8, 0xd5, 0x1c,
+	0xc2, 0xf3, 0x57, 0x26, 0x3e, 0x5f, 0xf3, 0x0a, 0xcd, 0xcf, 0x0c, 0x24,
+	0x39, 0x29, 0x66, 0xcf, 0xb4, 0x28, 0x37, 0x39, 0x7b, 0x11, 0xfc, 0xe6,
+	0x3f, 0x42, 0xa8, 0x38, 0xda, 0x8d, 0x60, 0x3f, 0xc3, 0xd5, 0x7b, 0x83,
+	0xd2, 0x26, 0x08, 0x8f, 0x06, 0xce, 0xe9, 0x42, 0xe0, 0x9a, 0xae, 0xcf,
+	0x2f, 0x2a, 0x28, 0x64, 0x30, 0x12, 0x95, 0x21, 0x16, 0x59, 0xa9, 0x1a,
+	0x5a, 0x5f, 0x9a, 0xb2, 0xdf, 0x8b, 0x71, 0x77, 0x2f, 0xc3, 0x2f, 0x6e,
+	0x0d, 0x20, 0x3f, 0x3b, 0x19, 0xaf, 0xfb, 0x18, 0xb6, 0x1c, 0xee, 0x42,
+	0x72, 0x02, 0x41, 0xfd, 0x4e, 0x0f, 0x6c, 0x16, 0x82, 0x93, 0xbf, 0xeb,
+	0xc3, 0x9f, 0x0e, 0x65, 0xaa, 0xd7, 0x11, 0x3c, 0x9d, 0x83, 0xe5, 0xe5,
+	0xad, 0x68, 0xac, 0xcd, 0x92, 0xe3, 0x79, 0x3b, 0x03, 0xca, 0x04, 0x4a,
+	0xb8, 0xab, 0x68, 0x47, 0x5e, 0x91, 0x6e, 0x44, 0xc2, 0x5e, 0x4d, 0x8b,
+	0x2b, 0xec, 0x18, 0x31, 0x23, 0x1c, 0xcb, 0x0a, 0xec, 0x32, 0xb3, 0xc5,
+	0x4f, 0x90, 0x52, 0x64, 0xc4, 0xaa, 0xb4, 0x75, 0x03, 0x66, 0x04, 0xb0,
+	0x59, 0x80, 0x9f, 0xed, 0xf2, 0xc8, 0xa0, 0xea, 0x5d, 0x45, 0x42, 0xa1,
+	0xb1, 0xb6, 0x75, 0x18, 0x11, 0x8d, 0x50, 0x2f, 0x29, 0xad, 0xf4, 0x9f,
+	0x23, 0x20, 0x9b, 0x95, 0x93, 0x58, 0xca, 0xf4, 0x14, 0x4d, 0x2e, 0xff,
+	0xd7, 0x3f, 0x95, 0x0c, 0x87, 0x75, 0x6c, 0x72, 0xa2, 0xfb, 0x25, 0x3a,
+	0x08, 0xf2, 0x66, 0x5a, 0x46, 0x04, 0x1b, 0x4b, 0x92, 0xcb, 0x87, 0x48,
+	0x30, 0x86, 0x06, 0x9d, 0x00, 0xd1, 0x0a, 0x10, 0x75, 0xfc, 0xcb, 0x6f,
+	0x4e, 0x83, 0xcb, 0x41, 0xf1, 0xac, 0xd3, 0x94, 0xb3, 0x17, 0x07, 0xe6,
+	0xef, 0xef, 0x0d, 0xe2, 0xce, 0xc3, 0x90, 0xac, 0x06, 0xf1, 0xfb, 0xca,
+	0xc6, 0x24, 0x7c, 0xfc, 0x23, 0x0e, 0xcc, 0xcb, 0xb5, 0x8e, 0x3a, 0xd3,
+	0xf1, 0x44, 0xac, 0x88, 0x22, 0x41, 0x00, 0xaf, 0xce, 0x39, 0xcd, 0x20,
+	0x44, 0x04, 0x82, 0x4c, 0x1e, 0xd1, 0xb5, 0x9a, 0x9f, 0x1a, 0x32, 0xe9,
+	0x44, 0x02, 0x9d, 0xa8, 0xf4, 0xc8, 0x5a, 0xfe, 0xf9, 0xcd, 0x01, 0xe1,
+	0x22, 0xdb, 0xab, 0x48, 0x3a, 0xf1, 0x2c, 0xba, 0xe1, 0xa2, 0xd9, 0xb6,
+	0x09, 0x07, 0x57, 0x22, 0xfc, 0xc5, 0x76, 0x94, 0x56, 0xb6, 0xa7, 0x52,
+	0x4e, 0xf8, 0x05, 0x65, 0x60, 0x1c, 0xb8, 0xd2, 0xf8, 0x56, 0x06, 0x17,
+	0xe3, 0xbb, 0x8f, 0xc3, 0xb8, 0xd7, 0x1a, 0xc6, 0x06, 0xaf, 0x13, 0xc5,
+	0x39, 0xd6, 0x68, 0xdf, 0xcf, 0x9e, 0xa6, 0xe3, 0xcd, 0x00, 0xc3, 0xd7,
+	0x4e, 0x4d, 0xfa, 0x13, 0x70, 0xb8, 0x10, 0x9c, 0xa5, 0x81, 0x1e, 0xea,
+	0x03, 0xc3, 0x7d, 0xa5, 0x8b, 0xc7, 0xfe, 0xf3, 0x41, 0x84, 0x0d, 0x8e,
+	0x1f, 0x6c, 0x73, 0x63, 0xce, 0x2c, 0x8b, 0xec, 0xef, 0x42, 0x7f, 0xf2,
+	0x4a, 0x9f, 0x24, 0xa1, 0xfc, 0xa6, 0x02, 0x2d, 0xc4, 0xab, 0x64, 0x86,
+	0xbd, 0xe7, 0x6b, 0xcf, 0x71, 0x12, 0xfe, 0x17, 0x0a, 0xee, 0x51, 0xc6,
+	0x58, 0x2c, 0x9e, 0x63, 0xc3, 0xa1, 0xad, 0x6e, 0x50, 0xf1, 0x75, 0x41,
+	0x89, 0x3c, 0x90, 0x36, 0x7e, 0xa7, 0x13, 0xb7, 0x0f, 0x67, 0x4e, 0x7a,
+	0xf9, 0x63, 0x45, 0xe4, 0x42, 0x34, 0xc5, 0x0b, 0x77, 0xfa, 0x57, 0x6a,
+	0x8c, 0xfc, 0x96, 0x92, 0x98, 0x13, 0x32, 0x46, 0xf2, 0x66, 0x58, 0x70,
+	0x70, 0x4b, 0x0a, 0xb2, 0xd3, 0x75, 0x44, 0x18, 0xe4, 0x51, 0xed, 0xef,
+	0x8a, 0x60, 0x55, 0x89, 0x7d, 0x4a, 0x24, 0x64, 0x70, 0x42, 0x48, 0xb4,
+	0x51, 0x37, 0xd5, 0xce, 0xb8, 0x4a, 0x09, 0xdb, 0xad, 0xc6, 0xf1, 0x78,
+	0xe8, 0x37, 0xf0, 0xc8, 0x6f, 0xe2, 0x9f, 0xcf, 0x0d, 0xfc, 0xb1, 0x69,
+	0x50, 0x96, 0xaa, 0x08, 0xfe, 0xff, 0x62, 0xd8, 0x6c, 0xff, 0x5a, 0x37,
+	0xb3, 0xa6, 0xd8, 0x17, 0x28, 0xd1, 0x08, 0x2f, 0x57, 0x3a, 0x85, 0xdc,
+	0xe9, 0x16, 0x2c, 0x2b, 0xb4, 0x61, 0xe3, 0xfe, 0xce, 0xe8, 0x39, 0xa0,
+	0x6b, 0x04, 0x77, 0x8e, 0x64, 0xc6, 0xbb, 0x4e, 0x4a, 0x46, 0x1c, 0x55,
+	0x3d, 0x4d, 0x83, 0x5f, 0x64, 0x20, 0x7f, 0x56, 0x63, 0x05, 0xdf, 0x1a,
+	0x97, 0x3c, 0x5e, 0x55, 0x70, 0xd5, 0x25, 0x45, 0x43, 0x51, 0x75, 0x3d,
+	0xd9, 0xe5, 0x8f, 0x7e, 0x90, 0xc4, 0x8b, 0xf8, 0x40, 0x4d, 0x30, 0x43,
+	0x77, 0x29, 0x10, 0x9d, 0xde, 0x87, 0xb2, 0x74, 0x79, 0x24, 0xbf, 0x1a,
+	0xe5, 0x7c, 0x57, 0xbd, 0x7e, 0x22, 0xb9, 0x10, 0x6d, 0x42, 0xe3, 0x11,
+	0x10, 0x52, 0x50, 0xf1, 0xb2, 0xcc, 0x4a, 0x23, 0xd7, 0x40, 0x31, 0xfa,
+	0x71, 0x36, 0x05, 0x12, 0xf1, 0xc1, 0xc7, 0x25, 0x20, 0xae, 0x92, 0xed,
+	0x6d, 0xdb, 0xa8, 0x86, 0x7a, 0x35, 0x9e, 0x0c, 0x11, 0x45, 0x46, 0x05,
+	0x8d, 0x0f, 0x3c, 0x21, 0x02, 0xe2, 0xfa, 0x70, 0xe5, 0x8b, 0x2a, 0xce,
+	0xb5, 0x2f, 0x13, 0x30, 0x77, 0x9c, 0xe9, 0x7f, 0x80, 0x18, 0x8d, 0x75,
+	0x59, 0x29, 0xa3, 0x05, 0x8d, 0xc5, 0xbf, 0x07, 0x00, 0xb7, 0x34, 0x59,
+	0x24, 0x61, 0x7d, 0xe4, 0x95, 0x00, 0x00, 0x00, 0x00, 0x49, 0x45, 0x4e,
+	0x44, 0xae, 0x42, 0x60, 0x82,
+}
+
+var Refreshing []byte = []byte{
+	0x00, 0x00, 0x01, 0x00, 0x01, 0x00, 0x20, 0x1f, 0x00, 0x00, 0x01, 0x00,
+	0x20, 0x00, 0x3e, 0x06, 0x00, 0x00, 0x16, 0x00, 0x00, 0x00, 0x89, 0x50,
+	0x4e, 0x47, 0x0d, 0x0a, 0x1a, 0x0a, 0x00, 0x00, 0x00, 0x0d, 0x49, 0x48,
+	0x44, 0x52, 0x00, 0x00, 0x00, 0x20, 0x00, 0x00, 0x00, 0x1f, 0x08, 0x06,
+	0x00, 0x00, 0x00, 0x86, 0x56, 0xcf, 0x8c, 0x00, 0x00, 0x06, 0x05, 0x49,
+	0x44, 0x41, 0x54, 0x78, 0x9c, 0xac, 0x56, 0x09, 0x6c, 0x54, 0x5d, 0x15,
+	0xfe, 0xee, 0x7d, 0x6f, 0xd6, 0x76, 0xda, 0x4e, 0xa7, 0x74, 0x61, 0xe9,
+	0xff, 0x57, 0x2c, 0x85, 0xd2, 0x55, 0x90, 0xa5, 0xc3, 0x92, 0x00, 0x26,
+	0x06, 0x85, 0xb0, 0x19, 0x25, 0x9a, 0x20, 0x12, 0xa2, 0x85, 0x29, 0x29,
+	0x2a, 0xa0, 0x56, 0x13, 0x0d, 0x18, 0x50, 0x11, 0x2a, 0x15, 0x84, 0x1a,
+	0x14, 0x09, 0x01, 0x52, 0x8c, 0x4a, 0x24, 0x51, 0x4c, 0x10, 0x05, 0x24,
+	0xa0, 0x54, 0x85, 0xc6, 0xca, 0x22, 0x2d, 0xdb, 0x74, 0x4a, 0x37, 0x3a,
+	0x74, 0xa1, 0x33, 0xef, 0xcd, 0xbd, 0xe6, 0x5e, 0xb9, 0xc3, 0x74, 0xba,
+	0xd8, 0x56, 0xbf, 0xf3, 0x26, 0xef, 0xdd, 0x73, 0xce, 0x9b, 0xf3, 0xdd,
+	0x7b, 0xcf, 0x39, 0xf7, 0xe9, 0x98, 0x00, 0x66, 0x6f, 0x09, 0xcc, 0xb2,
+	0x3a, 0xc9, 0x57, 0x38, 0x67, 0x6b, 0x00, 0x12, 0xe4, 0x0c, 0x27, 0xba,
+	0xfb, 0x06, 0x8e, 0x3e, 0xfd, 0x59, 0x4e, 0xb7, 0xf2, 0x19, 0x2b, 0x88,
+	0x7a, 0x18, 0x0b, 0xf2, 0xb7, 0xb5, 0x95, 0x58, 0x88, 0x59, 0x45, 0x09,
+	0xd6, 0x11, 0x02, 0xaa, 0xf4, 0x42, 0x38, 0x47, 0x90, 0x31, 0x7e, 0xac,
+	0x87, 0x0d, 0x54, 0x37, 0x1d, 0x9f, 0xde, 0xa6, 0xf4, 0xff, 0x17, 0x02,
+	0x05, 0xdb, 0xfd, 0x0b, 0x75, 0x4a, 0xaa, 0x08, 0xe7, 0x2b, 0x09, 0x21,
+	0xa3, 0xbe, 0xc3, 0x81, 0x7e, 0xc6, 0xc9, 0x8f, 0x19, 0xb1, 0x1e, 0x6c,
+	0xa8, 0xf1, 0xbc, 0x50, 0xfa, 0x09, 0x11, 0x28, 0xf2, 0xb5, 0x2e, 0xa3,
+	0x84, 0x55, 0x51, 0x60, 0x99, 0xd2, 0xc5, 0xe2, 0xbd, 0x74, 0x1d, 0x46,
+	0x84, 0xa3, 0xa5, 0x33, 0xa2, 0x54, 0xef, 0xc0, 0x10, 0x8e, 0x80, 0x9c,
+	0x36, 0xac, 0xda, 0x77, 0x1a, 0x0f, 0xa7, 0xff, 0x4b, 0xa9, 0xc7, 0x40,
+	0x80, 0x93, 0xe2, 0xf2, 0x97, 0x2b, 0xa9, 0xc6, 0xab, 0x08, 0xe1, 0x0b,
+	0x95, 0x76, 0x38, 0xfc, 0xa4, 0xd2, 0x83, 0x96, 0xae, 0x08, 0xbe, 0x7e,
+	0x7a, 0xe4, 0xad, 0x67, 0x40, 0x84, 0x80, 0xd4, 0x99, 0x21, 0xba, 0xbf,
+	0xa1, 0x36, 0xa3, 0x41, 0xe9, 0x15, 0x06, 0x25, 0x61, 0x61, 0xb9, 0x7f,
+	0x85, 0xa6, 0x05, 0xbe, 0x47, 0x09, 0x4a, 0x94, 0x6e, 0x34, 0xb4, 0x05,
+	0x19, 0xda, 0x82, 0x91, 0x11, 0xed, 0x42, 0x28, 0xa0, 0x01, 0x7c, 0xa3,
+	0x6e, 0x31, 0x3f, 0x55, 0xea, 0x0b, 0xfc, 0x9a, 0x82, 0xef, 0xa9, 0xff,
+	0xe1, 0xe4, 0xfb, 0x31, 0x76, 0x71, 0x03, 0x72, 0x77, 0x76, 0x4e, 0xd1,
+	0x35, 0x7a, 0x69, 0xac, 0xc1, 0x15, 0x18, 0x53, 0x4f, 0xa3, 0x83, 0x50,
+	0x91, 0x3d, 0x7c, 0x35, 0xe3, 0xb8, 0x5e, 0x58, 0xde, 0xed, 0x1e, 0xb2,
+	0x02, 0x89, 0xa1, 0xf0, 0x72, 0xa2, 0x71, 0x9b, 0x1a, 0x8f, 0x84, 0x9c,
+	0x0c, 0x1d, 0x2b, 0x4a, 0xed, 0xc8, 0xcf, 0xb6, 0x60, 0xde, 0x0c, 0x1b,
+	0x18, 0xb7, 0xc9, 0xe7, 0xfa, 0x47, 0x61, 0xfc, 0xe6, 0xce, 0x1b, 0xb9,
+	0x25, 0xca, 0x77, 0x38, 0x10, 0x8a, 0x34, 0x8a, 0xbe, 0x8f, 0x00, 0xa8,
+	0x1b, 0x44, 0x80, 0x53, 0xe6, 0x25, 0x31, 0x29, 0xf1, 0xcd, 0x4f, 0xa7,
+	0xe0, 0xa1, 0xdf, 0xc0, 0xd9, 0x3f, 0xf4, 0xc9, 0x71, 0x5a, 0x12, 0xc5,
+	0xae, 0x0d, 0xc9, 0x58, 0x5e, 0x6c, 0x47, 0x7c, 0x1d, 0xcc, 0xcf, 0xb3,
+	0xc9, 0xdf, 0xe7, 0x57, 0xba, 0x70, 0xf1, 0x56, 0x3f, 0x7e, 0x70, 0xb1,
+	0x07, 0xbd, 0x6f, 0x98, 0xf4, 0xdb, 0xbd, 0x21, 0x19, 0x21, 0x83, 0xa3,
+	0xfa, 0x57, 0xaf, 0x95, 0x3b, 0x40, 0xa9, 0x77, 0x08, 0x01, 0x70, 0x52,
+	0x16, 0x9b, 0x92, 0x8f, 0x5a, 0x0c, 0x6c, 0xfb, 0xb8, 0x0b, 0x0e, 0x1b,
+	0xc1, 0xdf, 0x1f, 0x87, 0xb1, 0xff, 0xb3, 0x6e, 0x78, 0x92, 0x28, 0x5a,
+	0x5f, 0x45, 0x70, 0xe1, 0x7a, 0x3f, 0x6e, 0xdd, 0x0f, 0xe1, 0xcc, 0xee,
+	0x34, 0x34, 0x05, 0x4c, 0x1c, 0xb8, 0x10, 0xc4, 0xe2, 0xd9, 0x76, 0xac,
+	0x2d, 0x73, 0x60, 0x5d, 0x99, 0x13, 0x25, 0x1f, 0xb0, 0x62, 0xef, 0xb9,
+	0x20, 0x3e, 0xb1, 0xc8, 0x89, 0xc5, 0x05, 0x36, 0xec, 0xaf, 0x8b, 0x09,
+	0x0e, 0x40, 0x63, 0xdc, 0xab, 0x9e, 0x65, 0xc8, 0xf7, 0x36, 0x35, 0xa7,
+	0xa4, 0xba, 0x6c, 0x9d, 0xf1, 0xcd, 0x65, 0xb2, 0x47, 0xc3, 0xb1, 0xed,
+	0x1e, 0x4c, 0x4b, 0xd3, 0xc4, 0x10, 0xe7, 0xaf, 0xf5, 0xe1, 0xc8, 0xc5,
+	0x1e, 0x39, 0xa3, 0x04, 0x3b, 0xc1, 0xb5, 0xef, 0x66, 0xe2, 0x79, 0xbb,
+	0x89, 0x35, 0x7b, 0xdb, 0x85, 0x19, 0xee, 0x44, 0x8a, 0x6f, 0x7d, 0x26,
+	0x05, 0xde, 0xfc, 0xff, 0xec, 0xe4, 0x83, 0x17, 0x06, 0x2a, 0x8e, 0x77,
+	0xa1, 0xf3, 0xf5, 0xe0, 0x44, 0x61, 0x9c, 0x98, 0x7e, 0xa6, 0xb9, 0xdb,
+	0x8f, 0xa5, 0xf7, 0xca, 0x24, 0x4c, 0x4e, 0xb0, 0x2f, 0x88, 0x0f, 0x2e,
+	0x44, 0xd4, 0x77, 0x53, 0xc0, 0x88, 0x26, 0x5b, 0x7b, 0x37, 0x83, 0x61,
+	0x72, 0x31, 0x84, 0xd3, 0x26, 0x5f, 0x45, 0xa2, 0xe3, 0xdd, 0x6b, 0x82,
+	0x58, 0x57, 0xef, 0xbb, 0x60, 0x7f, 0x7b, 0x1c, 0x1e, 0x12, 0x5c, 0x5c,
+	0x94, 0x70, 0x3d, 0x93, 0x1b, 0x0b, 0xa2, 0x55, 0xa0, 0x51, 0x5e, 0xa6,
+	0x8c, 0xb1, 0x98, 0x39, 0xcd, 0x82, 0xa5, 0x85, 0x76, 0x3c, 0x0e, 0x98,
+	0xe8, 0xee, 0x63, 0xa8, 0x58, 0xed, 0xc2, 0x8f, 0x7c, 0x1e, 0x64, 0xb8,
+	0x35, 0xb9, 0x02, 0xc2, 0x27, 0xf1, 0xed, 0xbd, 0xf0, 0x7d, 0x0b, 0xce,
+	0xee, 0x99, 0x84, 0x55, 0xf3, 0x1c, 0x68, 0x6a, 0x35, 0xd1, 0xd9, 0xc3,
+	0xb0, 0xb6, 0xcc, 0x89, 0x54, 0xd7, 0x90, 0x79, 0xc9, 0x4b, 0x23, 0xf0,
+	0x46, 0x73, 0x80, 0xc8, 0xfd, 0xe7, 0xf1, 0x3e, 0xf2, 0xcf, 0xc4, 0xfd,
+	0xe8, 0xa5, 0x1e, 0xb9, 0xd7, 0x35, 0xe5, 0xa9, 0x98, 0x9b, 0x6b, 0xc5,
+	0xf9, 0x3d, 0x69, 0xd1, 0x6c, 0xb7, 0xe8, 0x04, 0xa7, 0xbe, 0x98, 0x26,
+	0x2b, 0x41, 0xa3, 0xc0, 0xbd, 0xe6, 0x30, 0x2a, 0x6b, 0x5f, 0xc9, 0x5c,
+	0xf0, 0xad, 0x72, 0xe1, 0xa3, 0x73, 0x1c, 0xd1, 0x44, 0x8e, 0x15, 0xa2,
+	0x61, 0x91, 0xb8, 0x53, 0x2c, 0xbd, 0xaa, 0x73, 0xca, 0xe7, 0x2b, 0x43,
+	0x2c, 0x4a, 0xa7, 0x5b, 0x31, 0x10, 0xe6, 0xb8, 0xd9, 0x18, 0xc2, 0xf3,
+	0x0e, 0x13, 0x9f, 0xab, 0xee, 0x40, 0xe3, 0x33, 0x03, 0x49, 0x4e, 0x8a,
+	0x99, 0x53, 0x2d, 0xca, 0x4d, 0xce, 0x5e, 0x04, 0xbf, 0xf1, 0x8f, 0x10,
+	0xca, 0x8f, 0x76, 0x21, 0xd8, 0xc7, 0x70, 0xe5, 0xee, 0x80, 0xb4, 0x09,
+	0xc2, 0xc3, 0x81, 0x73, 0x3a, 0x1f, 0xb8, 0xaa, 0xeb, 0x73, 0x8b, 0x0a,
+	0x0a, 0x19, 0x8c, 0x44, 0x65, 0x88, 0x45, 0x56, 0xaa, 0x86, 0xe6, 0x97,
+	0xa6, 0xec, 0xf7, 0x62, 0xdc, 0xd5, 0xc3, 0xf0, 0x8b, 0x9b, 0xfd, 0xc8,
+	0xcf, 0x4e, 0xc6, 0xab, 0x5e, 0x86, 0xcd, 0x87, 0x3a, 0x91, 0x9c, 0x40,
+	0x50, 0xbb, 0xc3, 0x03, 0x9b, 0x85, 0xe0, 0xe4, 0xef, 0x7a, 0xf1, 0xa7,
+	0x83, 0x99, 0xea, 0x75, 0xbc, 0x5f, 0xd9, 0x81, 0x27, 0xd5, 0x69, 0xa8,
+	0x3f, 0x92, 0x25, 0xc7, 0x73, 0x76, 0x04, 0x94, 0x09, 0x94, 0x70, 0x57,
+	0xd1, 0xf6, 0xbc, 0x22, 0xdd, 0x88, 0x84, 0xbd, 0x9a, 0x16, 0x57, 0xd8,
+	0x31, 0x62, 0x46, 0x38, 0x96, 0x14, 0xd8, 0x65, 0x66, 0x8b, 0x9f, 0x20,
+	0xa5, 0xc8, 0x88, 0x55, 0x69, 0xe9, 0x02, 0xcc, 0x08, 0x60, 0xb3, 0x00,
+	0x3f, 0xdd, 0xe9, 0x91, 0x41, 0xd5, 0xbb, 0x8a, 0x84, 0xc2, 0x93, 0x38,
+	0x22, 0x1a, 0xa1, 0x5e, 0x52, 0x5a, 0xe1, 0x3f, 0x4b, 0x40, 0x36, 0x2a,
+	0x27, 0xb1, 0x94, 0xe9, 0x29, 0x9a, 0x5c, 0xfe, 0xaf, 0x7d, 0x32, 0x19,
+	0x0e, 0xeb, 0xc8, 0xe4, 0x44, 0xf7, 0x4b, 0x74, 0x10, 0xe4, 0x4d, 0xb5,
+	0x0c, 0x09, 0x36, 0x92, 0x88, 0x15, 0x51, 0x24, 0x18, 0x43, 0x9d, 0x4e,
+	0x80, 0x68, 0x05, 0x88, 0x3a, 0xfe, 0xe5, 0x37, 0x26, 0xc1, 0xe5, 0xa0,
+	0x78, 0xd6, 0x6e, 0xca, 0xd9, 0x8b, 0x03, 0xf3, 0xf7, 0x77, 0x07, 0x70,
+	0xfb, 0x41, 0x48, 0x56, 0x83, 0xf8, 0x7d, 0x79, 0x7d, 0x12, 0x3e, 0xf6,
+	0x61, 0x07, 0xe6, 0xe4, 0x5a, 0x87, 0x9d, 0xe9, 0x68, 0xa2, 0xb6, 0xe5,
+	0x6d, 0x13, 0xf2, 0xea, 0x9c, 0xd3, 0x0c, 0x42, 0x44, 0x20, 0xc8, 0xe4,
+	0x11, 0x5d, 0xab, 0xf1, 0xa9, 0x21, 0x93, 0x4e, 0x24, 0xd0, 0x89, 0x0a,
+	0x8f, 0xac, 0xe5, 0x9f, 0xdf, 0xe8, 0x17, 0x2e, 0xb2, 0xbd, 0x8a, 0xa4,
+	0x13, 0xcf, 0xa2, 0x1b, 0x2e, 0x98, 0x69, 0x1b, 0x73, 0x70, 0x25, 0xc2,
+	0x5f, 0x6c, 0x47, 0x69, 0x45, 0x6b, 0x2a, 0xe5, 0x84, 0x9f, 0x57, 0x06,
+	0xc6, 0x81, 0xcb, 0xf5, 0x6f, 0x64, 0x70, 0x31, 0xbe, 0xf3, 0x28, 0x8c,
+	0xbb, 0xcd, 0x61, 0xac, 0xf3, 0x3a, 0x51, 0x9c, 0x63, 0x8d, 0xf6, 0xfd,
+	0xec, 0x49, 0x3a, 0x5e, 0xf7, 0x33, 0x7c, 0xf5, 0xd4, 0xb8, 0x3f, 0x01,
+	0x07, 0x0b, 0xc1, 0x19, 0x1a, 0xe8, 0xa6, 0x3e, 0x30, 0xdc, 0x53, 0xba,
+	0x78, 0xec, 0x3b, 0x17, 0x44, 0xd8, 0xe0, 0xf8, 0xfe, 0x56, 0x37, 0x66,
+	0x4d, 0xb3, 0xc8, 0xfe, 0x2e, 0xf4, 0x27, 0x2f, 0xf7, 0x4a, 0x12, 0xca,
+	0x6f, 0x22, 0xd0, 0x42, 0xbc, 0x52, 0x66, 0xd8, 0x0c, 0x5f, 0x6b, 0x8e,
+	0x93, 0xf0, 0xbf, 0x50, 0x70, 0x8f, 0x32, 0xc6, 0x62, 0xe1, 0x2c, 0x1b,
+	0x0e, 0x6e, 0x71, 0x83, 0x8a, 0xaf, 0x0b, 0x4a, 0xe4, 0x81, 0xb4, 0xfe,
+	0xdb, 0xed, 0xb8, 0x75, 0x28, 0x73, 0xdc, 0xcb, 0x1f, 0x2b, 0x22, 0x17,
+	0xa2, 0x29, 0x5e, 0xb8, 0xc3, 0xbf, 0x5c, 0x63, 0xe4, 0xb7, 0x94, 0xc4,
+	0x9c, 0x90, 0x31, 0x92, 0x37, 0xc5, 0x82, 0x03, 0x9b, 0x53, 0x90, 0x9d,
+	0xae, 0x23, 0xc2, 0x20, 0x8f, 0x6a, 0x7f, 0x67, 0x04, 0x2b, 0x4a, 0xec,
+	0x13, 0x22, 0x21, 0x83, 0x13, 0x42, 0xa2, 0x8d, 0xba, 0xe1, 0xc8, 0x94,
+	0x2b, 0x94, 0xb0, 0x5d, 0x6a, 0x1c, 0x8f, 0x07, 0x7e, 0x03, 0x0f, 0xfd,
+	0x26, 0xfe, 0xf9, 0xdc, 0xc0, 0x1f, 0x1b, 0x06, 0x64, 0xa9, 0x8a, 0xe0,
+	0xff, 0x2b, 0x06, 0xcd, 0xf6, 0xaf, 0x35, 0x53, 0xab, 0x8b, 0x7d, 0x81,
+	0x12, 0x8d, 0xf0, 0x4d, 0x4a, 0xa7, 0x90, 0x3b, 0xd9, 0x82, 0x25, 0x85,
+	0x36, 0xac, 0xdf, 0xd7, 0x1e, 0x3d, 0x07, 0x74, 0x8d, 0xe0, 0xf6, 0xe1,
+	0xcc, 0x78, 0xd7, 0x71, 0xc9, 0x90, 0xa3, 0xaa, 0xbb, 0x61, 0xe0, 0x0b,
+	0x0c, 0xe4, 0xcf, 0x6a, 0xac, 0xe0, 0x5b, 0xe5, 0x92, 0xc7, 0xab, 0x0a,
+	0xae, 0xba, 0xa4, 0x68, 0x28, 0xaa, 0xae, 0xc7, 0xbb, 0xfc, 0xd1, 0x0f,
+	0x92, 0x78, 0x11, 0x1f, 0xa8, 0x09, 0x66, 0xe8, 0x0e, 0x05, 0xa2, 0xd3,
+	0xfb, 0x60, 0x96, 0x2e, 0x8f, 0xe4, 0x8e, 0x61, 0xce, 0x77, 0xd5, 0xeb,
+	0xc7, 0x92, 0x0b, 0xd1, 0x26, 0x34, 0x1a, 0x01, 0x21, 0x05, 0xe5, 0x2f,
+	0xcb, 0xac, 0x34, 0x72, 0x15, 0x14, 0xc3, 0x1f, 0x67, 0x13, 0x20, 0x11,
+	0x1f, 0x7c, 0x54, 0x02, 0xe2, 0x2a, 0xd9, 0xd6, 0xb2, 0x95, 0x6a, 0xa8,
+	0x55, 0xe3, 0xf1, 0x10, 0x51, 0x64, 0x54, 0xd0, 0xf8, 0xc0, 0x63, 0x22,
+	0x20, 0xae, 0x0f, 0x55, 0xbc, 0xa8, 0xe4, 0x5c, 0xfb, 0x12, 0x01, 0x73,
+	0xc7, 0x99, 0xfe, 0x0b, 0x88, 0x51, 0x5f, 0x93, 0x95, 0x32, 0x5c, 0xd0,
+	0x58, 0xfc, 0x7b, 0x00, 0x3b, 0xdc, 0x56, 0xe9, 0x08, 0x07, 0xb5, 0x1f,
+	0x00, 0x00, 0x00, 0x00, 0x49, 0x45, 0x4e, 0x44, 0xae, 0x42, 0x60, 0x82,
+}
+
+var Error []byte = []byte{
+	0x00, 0x00, 0x01, 0x00, 0x01, 0x00, 0x20, 0x1f, 0x00, 0x00, 0x01, 0x00,
+	0x20, 0x00, 0x44, 0x06, 0x00, 0x00, 0x16, 0x00, 0x00, 0x00, 0x89, 0x50,
+	0x4e, 0x47, 0x0d, 0x0a, 0x1a, 0x0a, 0x00, 0x00, 0x00, 0x0d, 0x49, 0x48,
+	0x44, 0x52, 0x00, 0x00, 0x00, 0x20, 0x00, 0x00, 0x00, 0x1f, 0x08, 0x06,
+	0x00, 0x00, 0x00, 0x86, 0x56, 0xcf, 0x8c, 0x00, 0x00, 0x06, 0x0b, 0x49,
+	0x44, 0x41, 0x54, 0x78, 0x9c, 0xac, 0x56, 0x0b, 0x6c, 0x54, 0x4b, 0x19,
+	0xfe, 0x66, 0xce, 0xd9, 0x67, 0xbb, 0x6d, 0xb7, 0x5b, 0xfa, 0xe0, 0xd1,
+	0x6b, 0xbd, 0xf6, 0x16, 0x7a, 0xfb, 0x94, 0xeb, 0x05, 0xba, 0xf7, 0x5e,
+	0x12, 0xc0, 0xc4, 0xa0, 0x10, 0x5e, 0x46, 0x89, 0x26, 0x88, 0x84, 0x68,
+	0x61, 0x4b, 0x8a, 0x0a, 0xa8, 0xd5, 0x44, 0x03, 0x06, 0x54, 0x84, 0x4a,
+	0x05, 0xa1, 0x06, 0x45, 0x42, 0x80, 0x14, 0xa3, 0x12, 0x49, 0x14, 0x13,
+	0x44, 0x01, 0x09, 0x28, 0xb5, 0x96, 0xc6, 0xca, 0x43, 0xda, 0xf0, 0xd8,
+	0x6e, 0x69, 0x69, 0xe9, 0xd2, 0x07, 0xdd, 0x3d, 0x67, 0x67, 0xcc, 0x8c,
+	0xce, 0x72, 0xba, 0x7d, 0xd8, 0xd6, 0xfb, 0xfd, 0x67, 0x73, 0xce, 0xfc,
+	0xff, 0x7f, 0xf6, 0xff, 0x66, 0xe6, 0xff, 0xff, 0x39, 0x3a, 0xa6, 0x81,
+	0x37, 0x37, 0x85, 0xe6, 0xd9, 0xdd, 0xe4, 0xab, 0x9c, 0xb3, 0x55, 0x00,
+	0x09, 0x73, 0x86, 0x63, 0x7d, 0x83, 0xc3, 0x87, 0x1f, 0xfe, 0x3c, 0xaf,
+	0x4f, 0xf9, 0x4c, 0x16, 0x44, 0x3d, 0x4c, 0x06, 0x85, 0x5b, 0xba, 0xca,
+	0x6c, 0xc4, 0xac, 0xa1, 0x04, 0x6b, 0x08, 0x01, 0x55, 0x7a, 0x21, 0x9c,
+	0x23, 0xcc, 0x18, 0x3f, 0xd2, 0xcf, 0x86, 0x6b, 0xdb, 0x8e, 0xbe, 0xde,
+	0xa5, 0xf4, 0xef, 0x0b, 0x81, 0xa2, 0xad, 0xc1, 0x45, 0x3a, 0x25, 0x35,
+	0x84, 0xf3, 0xe5, 0x84, 0x90, 0x09, 0xdf, 0xe1, 0xc0, 0x10, 0xe3, 0xe4,
+	0x27, 0x8c, 0xd8, 0xf7, 0xb7, 0xd4, 0xf9, 0x9e, 0x28, 0xfd, 0xb4, 0x08,
+	0x94, 0x04, 0x3a, 0x97, 0x50, 0xc2, 0x6a, 0x28, 0xb0, 0x44, 0xe9, 0xac,
+	0x78, 0x2d, 0x53, 0x87, 0x11, 0xe3, 0xe8, 0xe8, 0x89, 0x29, 0xd5, 0x2b,
+	0x30, 0x44, 0x63, 0x20, 0x27, 0x0d, 0xbb, 0xf6, 0xdd, 0xd6, 0x83, 0x99,
+	0xff, 0x52, 0xea, 0x49, 0x10, 0xe0, 0xa4, 0xb4, 0xf2, 0xe9, 0x72, 0xaa,
+	0xf1, 0x1a, 0x42, 0xf8, 0x22, 0xa5, 0x1d, 0x0b, 0x3f, 0xad, 0xf6, 0xa1,
+	0xa3, 0x37, 0x86, 0x6f, 0x9c, 0x1c, 0x7f, 0xeb, 0x19, 0x10, 0x23, 0x20,
+	0x0d, 0x66, 0x84, 0xee, 0x6d, 0xa9, 0xcf, 0x6a, 0x51, 0x7a, 0x85, 0x11,
+	0x49, 0x58, 0x5c, 0x19, 0x5c, 0xa6, 0x69, 0xa1, 0xef, 0x53, 0x82, 0x32,
+	0xa5, 0x9b, 0x08, 0x5d, 0x61, 0x86, 0xae, 0x70, 0x6c, 0x5c, 0xbb, 0x10,
+	0x0a, 0x68, 0x00, 0x5f, 0xaf, 0xdb, 0xcc, 0x4f, 0x97, 0x07, 0x42, 0xbf,
+	0xa1, 0xe0, 0xbb, 0x1a, 0x7f, 0x34, 0xf3, 0x8e, 0xc5, 0x2e, 0x6e, 0x40,
+	0xfe, 0xf6, 0x9e, 0x59, 0xba, 0x46, 0x2f, 0x4c, 0x36, 0xb8, 0x02, 0x63,
+	0xea, 0x69, 0x62, 0x10, 0x2a, 0xb2, 0x87, 0xaf, 0x64, 0x1c, 0x57, 0x8b,
+	0x2b, 0xfb, 0xbc, 0xa3, 0x56, 0x20, 0x39, 0x12, 0x5d, 0x4a, 0x34, 0xee,
+	0x50, 0xe3, 0xf1, 0x90, 0x97, 0xa5, 0x63, 0x59, 0xb9, 0x13, 0x85, 0xb9,
+	0x36, 0xbc, 0xfd, 0x86, 0x03, 0x8c, 0x3b, 0xe4, 0x73, 0xe3, 0xfd, 0x28,
+	0x7e, 0x7b, 0xeb, 0xa5, 0xdc, 0x12, 0xe5, 0x3b, 0x16, 0x08, 0x45, 0x06,
+	0xc5, 0xe0, 0x47, 0x01, 0x34, 0x8c, 0x20, 0xc0, 0x29, 0xf3, 0x13, 0x4b,
+	0x4a, 0x7c, 0xeb, 0x33, 0x69, 0xb8, 0x17, 0x34, 0x70, 0xfa, 0x8f, 0x83,
+	0x72, 0x9c, 0x91, 0x42, 0xb1, 0x63, 0x5d, 0x2a, 0x96, 0x96, 0x3a, 0x91,
+	0x58, 0x07, 0x0b, 0x0a, 0x1c, 0xf2, 0xf7, 0x85, 0xe5, 0x1e, 0x9c, 0xbf,
+	0x31, 0x84, 0x1f, 0x9e, 0xef, 0xc7, 0xc0, 0x4b, 0x26, 0xfd, 0x76, 0xae,
+	0x4b, 0x45, 0xc4, 0xe0, 0xa8, 0xfd, 0xf5, 0x0b, 0xe5, 0x0e, 0x50, 0xea,
+	0x1f, 0x45, 0x00, 0x9c, 0x54, 0x58, 0x53, 0xf2, 0x7e, 0x87, 0x81, 0x2d,
+	0x9f, 0xf0, 0xc0, 0xe5, 0x20, 0xf8, 0xfb, 0x83, 0x28, 0xf6, 0x7e, 0xce,
+	0x0b, 0x5f, 0x0a, 0x45, 0xe7, 0xf3, 0x18, 0xce, 0x5d, 0x1d, 0xc2, 0x8d,
+	0x3b, 0x11, 0x9c, 0xda, 0x99, 0x81, 0xb6, 0x90, 0x89, 0x7d, 0xe7, 0xc2,
+	0x78, 0xf7, 0x4d, 0x27, 0x56, 0x57, 0xb8, 0xb0, 0xa6, 0xc2, 0x8d, 0xb2,
+	0x0f, 0xda, 0xb1, 0xfb, 0x4c, 0x18, 0x9f, 0x7c, 0xc7, 0x8d, 0x77, 0x8b,
+	0x1c, 0xd8, 0xdb, 0x60, 0x09, 0x0e, 0x40, 0x63, 0xdc, 0xaf, 0x9e, 0x65,
+	0xc8, 0xd7, 0x36, 0xb4, 0xa7, 0xa5, 0x7b, 0x1c, 0x3d, 0x89, 0xcd, 0x65,
+	0xa6, 0x4f, 0xc3, 0x91, 0xad, 0x3e, 0xcc, 0xc9, 0xd0, 0xc4, 0x10, 0x67,
+	0xaf, 0x0c, 0xe2, 0xd0, 0xf9, 0x7e, 0x39, 0xa3, 0x24, 0x27, 0xc1, 0x95,
+	0xef, 0x65, 0xe3, 0x71, 0xb7, 0x89, 0x55, 0xbb, 0xbb, 0x85, 0x19, 0xde,
+	0x64, 0x8a, 0x6f, 0x7f, 0x36, 0x0d, 0xfe, 0xc2, 0xff, 0xec, 0xe4, 0xdd,
+	0x27, 0x06, 0xaa, 0x8e, 0xf6, 0xa2, 0xe7, 0xc5, 0xc8, 0x44, 0x61, 0x9c,
+	0x98, 0x41, 0xa6, 0x79, 0xbb, 0x8f, 0x64, 0x0e, 0xc8, 0x24, 0x4c, 0x4d,
+	0x72, 0x2e, 0x4c, 0x0c, 0x2e, 0x44, 0xd4, 0x77, 0x5b, 0xc8, 0x88, 0x27,
+	0x5b, 0x77, 0x1f, 0x83, 0x61, 0x72, 0x31, 0x84, 0xdb, 0x21, 0x5f, 0x45,
+	0xb2, 0xeb, 0xd5, 0x6b, 0x82, 0x58, 0xef, 0xc0, 0xab, 0x60, 0x4d, 0x0f,
+	0xa2, 0xa3, 0x82, 0x8b, 0x8b, 0x12, 0xae, 0x67, 0x73, 0x63, 0x61, 0xbc,
+	0x0a, 0x34, 0xca, 0x2b, 0x94, 0xd1, 0x8a, 0xb9, 0x73, 0x6c, 0x58, 0x5c,
+	0xec, 0xc4, 0x83, 0x90, 0x89, 0xbe, 0x41, 0x86, 0xaa, 0x95, 0x1e, 0xfc,
+	0x38, 0xe0, 0x43, 0x96, 0x57, 0x93, 0x2b, 0x20, 0x7c, 0x92, 0xff, 0x7b,
+	0x2f, 0xfe, 0x80, 0x0d, 0xa7, 0x77, 0xcd, 0xc0, 0x8a, 0xb7, 0x5d, 0x68,
+	0xeb, 0x34, 0xd1, 0xd3, 0xcf, 0xb0, 0xba, 0xc2, 0x8d, 0x74, 0xcf, 0xa8,
+	0x79, 0xc9, 0x4b, 0x23, 0xf0, 0xc7, 0x73, 0x80, 0xc8, 0xfd, 0xe7, 0x89,
+	0x3e, 0xf2, 0xcf, 0xc4, 0xfd, 0xf0, 0x85, 0x7e, 0xb9, 0xd7, 0x75, 0x95,
+	0xe9, 0x78, 0x2b, 0xdf, 0x8e, 0xb3, 0xbb, 0x32, 0xe2, 0xd9, 0x6e, 0xd3,
+	0x09, 0x4e, 0x7c, 0x29, 0x43, 0x56, 0x82, 0x46, 0x81, 0xdb, 0xed, 0x51,
+	0x54, 0xd7, 0x3f, 0x97, 0xb9, 0x10, 0x58, 0xe1, 0xc1, 0xc7, 0xe6, 0xbb,
+	0xe2, 0x89, 0x6c, 0x15, 0xa2, 0xe1, 0x1d, 0x71, 0xa7, 0x58, 0x7c, 0x59,
+	0xe7, 0x94, 0x2f, 0x50, 0x06, 0x2b, 0xca, 0x5f, 0xb7, 0x63, 0x38, 0xca,
+	0x71, 0xbd, 0x35, 0x82, 0xc7, 0xcf, 0x4c, 0x7c, 0xbe, 0xf6, 0x19, 0x5a,
+	0x1f, 0x19, 0x48, 0x71, 0x53, 0xcc, 0x9d, 0x6d, 0x53, 0x6e, 0x72, 0xf6,
+	0x22, 0xf8, 0xb5, 0x7f, 0x44, 0x50, 0x79, 0xb8, 0x17, 0xe1, 0x41, 0x86,
+	0x4b, 0xcd, 0xc3, 0xd2, 0x26, 0x08, 0x8f, 0x05, 0xce, 0xe9, 0x02, 0xe0,
+	0xb2, 0xae, 0xbf, 0x55, 0x52, 0x54, 0xcc, 0x60, 0x24, 0x2b, 0x83, 0x15,
+	0x39, 0xe9, 0x1a, 0xda, 0x9f, 0x9a, 0xb2, 0xdf, 0x8b, 0x71, 0x6f, 0x3f,
+	0xc3, 0x2f, 0xaf, 0x0f, 0xa1, 0x30, 0x37, 0x15, 0xcf, 0x07, 0x18, 0x36,
+	0x1e, 0xe8, 0x41, 0x6a, 0x12, 0x41, 0xfd, 0x36, 0x1f, 0x1c, 0x36, 0x82,
+	0xe3, 0xbf, 0x1f, 0xc0, 0x9f, 0xf7, 0x67, 0xab, 0xd7, 0xd1, 0x5c, 0x5e,
+	0x8e, 0xc5, 0x4d, 0x4d, 0x68, 0x3c, 0x94, 0x23, 0xc7, 0xf3, 0xb7, 0x85,
+	0x94, 0x09, 0x94, 0x70, 0x4f, 0xc9, 0xd6, 0x82, 0x12, 0xdd, 0x88, 0x45,
+	0xfd, 0x9a, 0x96, 0x50, 0xd8, 0x16, 0x31, 0x63, 0x1c, 0xef, 0x15, 0x39,
+	0x65, 0x66, 0x8b, 0x9f, 0x20, 0xa5, 0xc8, 0x88, 0x55, 0xe9, 0xe8, 0x05,
+	0xcc, 0x18, 0xe0, 0xb0, 0x01, 0x3f, 0xdb, 0xee, 0x93, 0x41, 0xd5, 0xbb,
+	0x8a, 0x84, 0x42, 0x63, 0x53, 0xd3, 0x08, 0x22, 0x1a, 0xa1, 0x7e, 0x52,
+	0x5e, 0x15, 0x3c, 0x4d, 0x40, 0xd6, 0x2b, 0x27, 0xb1, 0x94, 0x99, 0x69,
+	0x9a, 0x5c, 0xfe, 0xaf, 0x7f, 0x2a, 0x15, 0x2e, 0xfb, 0xf8, 0xe4, 0x44,
+	0xf7, 0x4b, 0x76, 0x11, 0x14, 0xcc, 0xb6, 0x8d, 0x0a, 0x36, 0x9e, 0x94,
+	0x5a, 0x48, 0x30, 0x86, 0x06, 0x9d, 0x00, 0xf1, 0x0a, 0x10, 0x75, 0xfc,
+	0xab, 0x6f, 0xce, 0x80, 0xc7, 0x45, 0xf1, 0xa8, 0xdb, 0x94, 0xb3, 0x17,
+	0x07, 0xe6, 0x1f, 0x9a, 0x87, 0x71, 0xf3, 0x6e, 0x44, 0x56, 0x83, 0xf8,
+	0x7d, 0x65, 0x6d, 0x0a, 0x3e, 0xfe, 0x11, 0x17, 0xe6, 0xe7, 0xdb, 0xc7,
+	0x9c, 0xe9, 0x44, 0x22, 0x56, 0x44, 0x91, 0x20, 0x80, 0x5f, 0xe7, 0x9c,
+	0x66, 0x11, 0x22, 0x02, 0x41, 0x26, 0x8f, 0xe8, 0x5a, 0xad, 0x0f, 0x0d,
+	0x99, 0x74, 0x22, 0x81, 0x8e, 0x55, 0xf9, 0x64, 0x2d, 0xff, 0xe2, 0xda,
+	0x90, 0x70, 0x91, 0xed, 0x55, 0x24, 0x9d, 0x78, 0x16, 0xdd, 0x70, 0xe1,
+	0x5c, 0xc7, 0xa4, 0x83, 0x2b, 0x11, 0xfe, 0x62, 0x3b, 0xca, 0xab, 0x3a,
+	0xd3, 0x29, 0x27, 0xfc, 0xac, 0x32, 0x30, 0x0e, 0x5c, 0x6c, 0x7c, 0x29,
+	0x83, 0x8b, 0xf1, 0xad, 0xfb, 0x51, 0x34, 0xb7, 0x47, 0xb1, 0xc6, 0xef,
+	0x46, 0x69, 0x9e, 0x3d, 0xde, 0xf7, 0x73, 0x67, 0xe8, 0x78, 0x31, 0xc4,
+	0xf0, 0xb5, 0x13, 0x53, 0xfe, 0x04, 0x1c, 0x29, 0x04, 0xa7, 0x68, 0xa8,
+	0x8f, 0x06, 0xc0, 0x70, 0x5b, 0xe9, 0x12, 0xb1, 0xe7, 0x4c, 0x18, 0x51,
+	0x83, 0xe3, 0x07, 0x9b, 0xbd, 0x98, 0x37, 0xc7, 0x26, 0xfb, 0xbb, 0xd0,
+	0x1f, 0xbf, 0x38, 0x20, 0x49, 0x28, 0xbf, 0xe9, 0x40, 0x8b, 0xf0, 0x6a,
+	0x99, 0x61, 0x6f, 0x04, 0x3a, 0xf3, 0xdc, 0x84, 0xff, 0x95, 0x82, 0xfb,
+	0x94, 0xd1, 0x8a, 0x45, 0xf3, 0x1c, 0xd8, 0xbf, 0xc9, 0x0b, 0x2a, 0xbe,
+	0x2e, 0x28, 0x91, 0x07, 0xd2, 0xda, 0xef, 0x74, 0xe3, 0xc6, 0x81, 0xec,
+	0x29, 0x2f, 0xbf, 0x55, 0x44, 0x2e, 0xc4, 0x53, 0xbc, 0x78, 0x5b, 0x70,
+	0xa9, 0xc6, 0xc8, 0xef, 0x28, 0xb1, 0x9c, 0x90, 0x16, 0x29, 0x98, 0x65,
+	0xc3, 0xbe, 0x8d, 0x69, 0xc8, 0xcd, 0xd4, 0x11, 0x63, 0x90, 0x47, 0x75,
+	0xb0, 0x27, 0x86, 0x65, 0x65, 0xce, 0x69, 0x91, 0x90, 0xc1, 0x09, 0x21,
+	0xf1, 0x46, 0xdd, 0x72, 0x68, 0xd6, 0x25, 0x4a, 0xd8, 0x0e, 0x35, 0x4e,
+	0xc4, 0xdd, 0xa0, 0x81, 0x7b, 0x41, 0x13, 0xff, 0x7c, 0x6c, 0xe0, 0x4f,
+	0x2d, 0xc3, 0xb2, 0x54, 0x45, 0xf0, 0xff, 0x17, 0x23, 0x66, 0xfb, 0xb7,
+	0xba, 0xd9, 0xb5, 0xa5, 0x81, 0x50, 0x99, 0x46, 0xf8, 0x06, 0xa5, 0x53,
+	0xc8, 0x9f, 0x69, 0xc3, 0x7b, 0xc5, 0x0e, 0xac, 0xdd, 0xd3, 0x1d, 0x3f,
+	0x07, 0x74, 0x8d, 0xe0, 0xe6, 0xc1, 0xec, 0x44, 0xd7, 0x29, 0xc9, 0xa8,
+	0xa3, 0xaa, 0xaf, 0x65, 0xf8, 0x8b, 0x0c, 0xe4, 0x2f, 0x6a, 0xac, 0x10,
+	0x58, 0xe1, 0x91, 0xc7, 0xab, 0x0a, 0xae, 0xba, 0xa4, 0x68, 0xaf, 0xaa,
+	0xae, 0xa7, 0xba, 0xfc, 0xf1, 0x0f, 0x92, 0x44, 0x11, 0x1f, 0xa8, 0x49,
+	0x66, 0xe4, 0x16, 0x05, 0xe2, 0xd3, 0xfb, 0x50, 0x8e, 0x2e, 0x8f, 0xe4,
+	0x67, 0x63, 0x9c, 0xef, 0xaa, 0xd7, 0x4f, 0x26, 0x17, 0xe2, 0x4d, 0x68,
+	0x22, 0x02, 0x42, 0x8a, 0x2a, 0x9f, 0x56, 0xd8, 0x69, 0xec, 0x32, 0x28,
+	0xc6, 0x3e, 0xce, 0xa6, 0x41, 0x22, 0x31, 0xf8, 0x84, 0x04, 0xc4, 0x55,
+	0xb6, 0xa5, 0x63, 0x33, 0xd5, 0x50, 0xaf, 0xc6, 0x53, 0x21, 0xa2, 0xc8,
+	0xa8, 0xa0, 0x89, 0x81, 0x27, 0x45, 0x40, 0x5c, 0x1f, 0xae, 0x7a, 0x52,
+	0xcd, 0xb9, 0xf6, 0x65, 0x02, 0xe6, 0x4d, 0x30, 0xfd, 0x0f, 0x10, 0xa3,
+	0xb1, 0x2e, 0x27, 0x6d, 0xac, 0xa0, 0x56, 0xfc, 0x7b, 0x00, 0xae, 0x18,
+	0x57, 0xde, 0x63, 0xc4, 0x6d, 0x89, 0x00, 0x00, 0x00, 0x00, 0x49, 0x45,
+	0x4e, 0x44, 0xae, 0x42, 0x60, 0x82,
+}
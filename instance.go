@@ -0,0 +1,88 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"k8s.io/client-go/util/homedir"
+)
+
+const instanceLockName = "kube-tray.lock"
+
+// errInstanceLocked is returned by lockFile when another process holds the lock
+var errInstanceLocked = errors.New("locked by another process")
+
+// instanceLockFile stays open while the tray runs, the OS releases its lock when the process exits
+var instanceLockFile *os.File
+
+func getInstanceLockPath() string {
+	return filepath.Join(homedir.HomeDir(), ".kube-tray", instanceLockName)
+}
+
+// AcquireInstanceLock takes the lock of the running instance and returns 0, or the pid of the running instance
+// holding it, the lock is held from the start so a slowly starting instance keeps it
+func AcquireInstanceLock() (int, error) {
+	path := getInstanceLockPath()
+	os.MkdirAll(filepath.Dir(path), 0755)
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return 0, err
+	}
+	if err := lockFile(file); err != nil {
+		file.Close()
+		if !errors.Is(err, errInstanceLocked) {
+			return 0, fmt.Errorf("unable to lock %s: %w", path, err)
+		}
+		pid, err := readInstanceLock(path)
+		if err != nil {
+			return 0, fmt.Errorf("%s is locked by an instance which did not write its pid yet: %w", path, err)
+		}
+		return pid, nil
+	}
+	// The pid is only informative, the lock tells whether an instance is running
+	if err := file.Truncate(0); err != nil {
+		file.Close()
+		return 0, err
+	}
+	if _, err := fmt.Fprintf(file, "%d\n", os.Getpid()); err != nil {
+		file.Close()
+		return 0, err
+	}
+	instanceLockFile = file
+	return 0, nil
+}
+
+// ReleaseInstanceLock unlocks the lock file, it is left in place for the next instance
+func ReleaseInstanceLock() {
+	if instanceLockFile != nil {
+		instanceLockFile.Close()
+		instanceLockFile = nil
+	}
+}
+
+// GetRunningInstance returns the pid of the instance holding the lock
+func GetRunningInstance() (int, bool) {
+	path := getInstanceLockPath()
+	file, err := os.OpenFile(path, os.O_RDWR, 0644)
+	if err != nil {
+		return 0, false
+	}
+	defer file.Close()
+	if err := lockFile(file); !errors.Is(err, errInstanceLocked) {
+		return 0, false
+	}
+	pid, err := readInstanceLock(path)
+	return pid, err == nil
+}
+
+func readInstanceLock(path string) (int, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(strings.TrimSpace(string(content)))
+}
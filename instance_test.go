@@ -0,0 +1,41 @@
+package main
+
+import (
+	"os"
+	"testing"
+)
+
+func TestInstanceLock(t *testing.T) {
+	setupTestHome(t)
+	if _, ok := GetRunningInstance(); ok {
+		t.Fatal("instance running before the lock was taken")
+	}
+	pid, err := AcquireInstanceLock()
+	if err != nil || pid != 0 {
+		t.Fatalf("first lock returned %d, %v", pid, err)
+	}
+	defer ReleaseInstanceLock()
+
+	// The lock is held without the control server answering, like during a slow start
+	if pid, ok := GetRunningInstance(); !ok || pid != os.Getpid() {
+		t.Errorf("running instance %d, %v, want %d", pid, ok, os.Getpid())
+	}
+	held := instanceLockFile
+	pid, err = AcquireInstanceLock()
+	if err != nil || pid != os.Getpid() {
+		t.Errorf("second lock returned %d, %v, want the pid of the holder", pid, err)
+	}
+	if instanceLockFile != held {
+		t.Error("second lock replaced the held lock")
+	}
+
+	ReleaseInstanceLock()
+	if _, ok := GetRunningInstance(); ok {
+		t.Error("instance running after the lock was released")
+	}
+	// A lock file left behind, e.g. by a crash, does not block the next instance
+	pid, err = AcquireInstanceLock()
+	if err != nil || pid != 0 {
+		t.Errorf("lock after release returned %d, %v", pid, err)
+	}
+}
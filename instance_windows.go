@@ -0,0 +1,20 @@
+//go:build windows
+// +build windows
+
+package main
+
+import (
+	"os"
+
+	"golang.org/x/sys/windows"
+)
+
+// lockFile locks a byte far past the pid without waiting, a locked range cannot be read by other processes
+func lockFile(file *os.File) error {
+	overlapped := &windows.Overlapped{OffsetHigh: 1}
+	err := windows.LockFileEx(windows.Handle(file.Fd()), windows.LOCKFILE_EXCLUSIVE_LOCK|windows.LOCKFILE_FAIL_IMMEDIATELY, 0, 1, 0, overlapped)
+	if err == windows.ERROR_LOCK_VIOLATION {
+		return errInstanceLocked
+	}
+	return err
+}
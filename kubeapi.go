@@ -2,60 +2,224 @@ package main
 
 import (
 	"context"
-	"path/filepath"
+	"errors"
+	"strings"
 
+	authorizationv1 "k8s.io/api/authorization/v1"
 	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 )
 
-func (rootElement *Element) UpdateData() {
-	kubeLog.Info("Updating Data for all contexts")
-	// Mark all for pending deletion
-	rootElement.ElementTraversalMarkNonUpdated()
-	// Update contexts
-	for _, ctx := range existingContext {
-		rootElement.UpdateContextData(ctx)
-	}
-	// Delete missing elements after updates
-	rootElement.ElementTraversalDisposeNonUpdated()
+// How the namespaces of a context were resolved when listing them is forbidden
+const (
+	NamespaceSourceList    = ""
+	NamespaceSourceContext = "from context"
+	NamespaceSourceConfig  = "from config"
+	NamespaceSourceProbe   = "probed"
+)
+
+// ContextData is the result of refreshing a single context
+type ContextData struct {
+	Context    string
+	Namespaces []string
+	Source     string
+	Health     ContextHealth
+	Problems   map[string][]PodProblem
+	Workloads  map[string][]Workload
+	// Standalone kubeconfig the namespaces were resolved with, written once the result is applied
+	Kubeconfig *clientcmdapi.Config
+	Err        error
 }
 
-func GetNamespaces(path string) []v1.Namespace {
-	config, err := clientcmd.BuildConfigFromFlags("", path)
-	if err != nil {
-		kubeLog.Warning(err)
-		return []v1.Namespace{}
-	}
-	clientset, err := kubernetes.NewForConfig(config)
+func NewRestConfig(config *clientcmdapi.Config) (*rest.Config, error) {
+	return clientcmd.NewDefaultClientConfig(*config, &clientcmd.ConfigOverrides{}).ClientConfig()
+}
+
+// NewClientset creates the clientset of a standalone kubeconfig, tests replace it with fake clientsets
+var NewClientset = newClientset
+
+func newClientset(config *clientcmdapi.Config) (kubernetes.Interface, error) {
+	restConfig, err := NewRestConfig(config)
 	if err != nil {
-		kubeLog.Warning(err)
-		return []v1.Namespace{}
+		return nil, err
 	}
-	namespaces, err := clientset.CoreV1().Namespaces().List(context.TODO(), metav1.ListOptions{})
+	return kubernetes.NewForConfig(restConfig)
+}
+
+func GetNamespaces(reqContext context.Context, clientset kubernetes.Interface) ([]v1.Namespace, error) {
+	namespaces, err := clientset.CoreV1().Namespaces().List(reqContext, metav1.ListOptions{})
 	if err != nil {
-		kubeLog.Warning(err)
-		return []v1.Namespace{}
+		return []v1.Namespace{}, err
 	}
-	return namespaces.Items
+	return namespaces.Items, nil
 }
 
-func (rootElement *Element) UpdateContextData(ctx string) {
-	ctxElement, ok := rootElement.Children[ctx]
+// FetchContextData resolves the namespaces of the context, nothing is written until the result is saved
+func FetchContextData(reqContext context.Context, ctx string) ContextData {
+	data := ContextData{Context: ctx}
+	if data.Err = reqContext.Err(); data.Err != nil {
+		return data
+	}
+	config, ok := GetContextKubeconfig(ctx)
 	if !ok {
-		ctxElement = rootElement.UpsertContext(ctx)
+		return data
+	}
+	clientset, err := NewClientset(config)
+	if err != nil {
+		data.Err = err
+		return data
+	}
+	data.Health = ProbeHealth(reqContext, clientset)
+	if data.Err = reqContext.Err(); data.Err != nil {
+		// A probe cut off by a newer refresh or the deadline tells nothing about the cluster
+		data.Health = GetContextHealth(ctx)
+		return data
+	}
+	setContextHealth(ctx, data.Health)
+	if data.Health.Status == HealthUnreachable {
+		data.Err = errors.New(data.Health.Message)
+		return data
+	}
+	data.Namespaces, data.Source, data.Err = ResolveNamespaces(reqContext, clientset, ctx, config.Contexts[ctx].Namespace)
+	if data.Err != nil {
+		return data
+	}
+	data.Kubeconfig = config
+	pendingThreshold := GetConfig().Problems.PendingThreshold.Duration()
+	if data.Problems, err = GetPodProblems(reqContext, clientset, pendingThreshold); err != nil {
+		kubeLog.Warningf("Unable to list pods of [%s]: %s", ctx, err)
 	} else {
-		ctxElement.Updated = true
+		setContextProblems(ctx, data.Problems)
 	}
+	data.Workloads = GetVisitedWorkloads(reqContext, clientset, ctx)
+	return data
+}
 
-	ctxElement.UpdateNamespaceData()
+// ResolveNamespaces lists namespaces of the context, falling back to the context namespace,
+// the namespaces configured for the context and finally an access review probe of the default namespace and
+// the namespaces of other contexts on the same cluster when listing is forbidden
+func ResolveNamespaces(reqContext context.Context, clientset kubernetes.Interface, ctx string, contextNamespace string) ([]string, string, error) {
+	namespaceItems, err := GetNamespaces(reqContext, clientset)
+	if err == nil {
+		namespaces := []string{}
+		for _, nsItem := range namespaceItems {
+			namespaces = append(namespaces, nsItem.Name)
+		}
+		return namespaces, NamespaceSourceList, nil
+	}
+	if !apierrors.IsForbidden(err) {
+		return []string{}, NamespaceSourceList, err
+	}
+	kubeLog.Warning(err)
+
+	if contextNamespace != "" {
+		return []string{contextNamespace}, NamespaceSourceContext, nil
+	}
+	if namespaces := GetContextNamespaces(ctx); len(namespaces) > 0 {
+		return namespaces, NamespaceSourceConfig, nil
+	}
+	return ProbeNamespaces(reqContext, clientset, GetSiblingNamespaces(ctx)), NamespaceSourceProbe, nil
+}
+
+// ProbeNamespaces returns the candidate namespaces in which the current user is allowed to work
+func ProbeNamespaces(reqContext context.Context, clientset kubernetes.Interface, candidates []string) []string {
+	namespaces := []string{}
+	for _, ns := range candidates {
+		rulesReview, err := clientset.AuthorizationV1().SelfSubjectRulesReviews().Create(reqContext, &authorizationv1.SelfSubjectRulesReview{
+			Spec: authorizationv1.SelfSubjectRulesReviewSpec{Namespace: ns},
+		}, metav1.CreateOptions{})
+		if err == nil && !rulesReview.Status.Incomplete {
+			if hasNamespacedRules(rulesReview.Status.ResourceRules) {
+				namespaces = append(namespaces, ns)
+			}
+			continue
+		}
+		// Authorizers such as webhooks are unable to evaluate rules, ask for a single permission instead
+		accessReview, err := clientset.AuthorizationV1().SelfSubjectAccessReviews().Create(reqContext, &authorizationv1.SelfSubjectAccessReview{
+			Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+				ResourceAttributes: &authorizationv1.ResourceAttributes{
+					Namespace: ns,
+					Verb:      "list",
+					Resource:  "pods",
+				},
+			},
+		}, metav1.CreateOptions{})
+		if err != nil {
+			kubeLog.Warning(err)
+			continue
+		}
+		if accessReview.Status.Allowed {
+			namespaces = append(namespaces, ns)
+		}
+	}
+	return namespaces
+}
+
+// hasNamespacedRules ignores the rules granted to every authenticated user for self reviews
+func hasNamespacedRules(rules []authorizationv1.ResourceRule) bool {
+	for _, rule := range rules {
+		for _, resource := range rule.Resources {
+			if !strings.HasPrefix(resource, "selfsubject") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ApplyContextData reflects the refresh result of a context in the tree
+func (rootElement *Element) ApplyContextData(data ContextData) {
+	if data.Err != nil {
+		kubeLog.Warningf("Unable to refresh [%s]: %s", data.Context, data.Err)
+		// Keep the last known state of unreachable contexts
+		if ctxElement, ok := rootElement.Children[data.Context]; ok {
+			ctxElement.ElementTraversalMarkUpdated()
+			ctxElement.SetHealth(data.Health)
+		}
+		return
+	}
+
+	if !SaveContextData(data) {
+		kubeLog.Infof("Dropped outdated refresh of [%s]", data.Context)
+		return
+	}
+
+	// Contexts without accessible namespace stay visible to tell why they are empty
+	ctxElement := rootElement.UpsertContext(data.Context)
+	ctxElement.SetInvalid("")
+	ctxElement.SetNamespaceSource(data.Source)
+	ctxElement.SetHealth(data.Health)
+	ctxElement.SetNoNamespaces(len(data.Namespaces) == 0)
+	for _, ns := range data.Namespaces {
+		nsElement := ctxElement.UpsertNamespace(ns)
+		nsElement.SetProblems(data.Problems[ns])
+		if workloads, ok := data.Workloads[ns]; ok {
+			nsElement.SetWorkloads(data.Context, workloads)
+		} else if workloadsElement, ok := nsElement.Children[workloadsTitle]; ok {
+			// Not visited yet or failed to list, keep what is shown
+			workloadsElement.ElementTraversalMarkUpdated()
+		}
+	}
+}
+
+// LoadCachedData fills the tree from the kubeconfigs written by previous runs
+func (rootElement *Element) LoadCachedData() {
+	rootElement.mutex.Lock()
+	defer rootElement.mutex.Unlock()
+	for _, ctx := range existingContext {
+		rootElement.UpsertContext(ctx).UpdateNamespaceData()
+	}
+	rootElement.upsertInvalidContexts()
 }
 
 func (ctxElement *Element) UpdateNamespaceData() {
-	matches, _ := filepath.Glob(filepath.Join(contextDirectory, ctxElement.Title, "*"))
-	for _, match := range matches {
-		ns := filepath.Base(match)
+	ctxElement.SetNamespaceSource(ReadNamespaceSource(ctxElement.Title))
+	for _, ns := range ReadContextNamespaces(ctxElement.Title) {
 		ctxElement.UpsertNamespace(ns)
 	}
 }
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+// newForbiddenClientset forbids listing namespaces, the rules review of the default namespace grants the rules
+func newForbiddenClientset(rules ...authorizationv1.ResourceRule) *fake.Clientset {
+	clientset := fake.NewSimpleClientset()
+	clientset.PrependReactor("list", "namespaces", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, apierrors.NewForbidden(schema.GroupResource{Resource: "namespaces"}, "", errors.New("not allowed"))
+	})
+	clientset.PrependReactor("create", "selfsubjectrulesreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectRulesReview)
+		review.Status.ResourceRules = rules
+		return true, review, nil
+	})
+	return clientset
+}
+
+func TestContextWithoutAccessibleNamespaces(t *testing.T) {
+	setupTestClusters(t, map[string]kubernetes.Interface{"restricted": newForbiddenClientset()})
+	root, menu := newTestRoot(t)
+	root.UpdateData()
+
+	item := menu.Find("restricted (no accessible namespaces)")
+	assertVisible(t, item, true, "restricted (no accessible namespaces)")
+	if got := len(root.Children["restricted"].Children); got != 2 {
+		t.Errorf("context has %d entries, want only Refresh and Set as current", got)
+	}
+}
+
+func TestContextWithProbedNamespace(t *testing.T) {
+	setupTestClusters(t, map[string]kubernetes.Interface{"restricted": newForbiddenClientset(authorizationv1.ResourceRule{
+		Verbs:     []string{"list"},
+		Resources: []string{"pods"},
+	})})
+	root, menu := newTestRoot(t)
+	root.UpdateData()
+
+	assertVisible(t, menu.Find("restricted (namespaces probed)", "default"), true, "restricted (namespaces probed)", "default")
+	if root.Children["restricted"].NoNamespaces {
+		t.Error("context with a probed namespace is marked without namespaces")
+	}
+}
+
+// TestProbeSiblingNamespaces probes the namespace of another context on the same cluster, the user only being
+// allowed in it
+func TestProbeSiblingNamespaces(t *testing.T) {
+	setupTestHome(t)
+	config := newTestKubeconfig("restricted", "other")
+	config.Contexts["team-a"] = &clientcmdapi.Context{Cluster: "other", AuthInfo: "other", Namespace: "team-a"}
+	config.Contexts["elsewhere"] = &clientcmdapi.Context{Cluster: "restricted", AuthInfo: "restricted", Namespace: "team-b"}
+	config.Clusters["other"].Server = config.Clusters["restricted"].Server
+	writeTestKubeconfig(t, config)
+	LoadKubeconfig(false)
+
+	probed := []string{}
+	clientset := newForbiddenClientset()
+	clientset.PrependReactor("create", "selfsubjectrulesreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectRulesReview)
+		probed = append(probed, review.Spec.Namespace)
+		if review.Spec.Namespace == "team-a" {
+			review.Status.ResourceRules = []authorizationv1.ResourceRule{{Verbs: []string{"list"}, Resources: []string{"pods"}}}
+		}
+		return true, review, nil
+	})
+	namespaces, source, err := ResolveNamespaces(context.Background(), clientset, "restricted", "")
+	if err != nil || source != NamespaceSourceProbe {
+		t.Fatalf("resolved %v, %q, %v", namespaces, source, err)
+	}
+	if !reflect.DeepEqual(probed, []string{"default", "team-b", "team-a"}) {
+		t.Errorf("probed %v, want default and the namespaces of the contexts on the same cluster", probed)
+	}
+	if !reflect.DeepEqual(namespaces, []string{"team-a"}) {
+		t.Errorf("resolved namespaces %v, want team-a", namespaces)
+	}
+}
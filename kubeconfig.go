@@ -1,33 +1,183 @@
 package main
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
 
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/clientcmd"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 	"k8s.io/client-go/util/homedir"
 )
 
+const namespaceSourceFile = ".namespace-source"
+
+var (
+	kubeconfigMutex  sync.Mutex
+	loadedKubeconfig map[string]*clientcmdapi.Config
+	// Contexts with dangling references and why they can't be used
+	invalidContexts map[string]string
+	currentContext  string
+)
+
+// LoadKubeconfig splits the default kubeconfig per context, namespaces are resolved on the next refresh
 func LoadKubeconfig(clean bool) {
+	kubeconfigMutex.Lock()
+	defer kubeconfigMutex.Unlock()
+
 	home := homedir.HomeDir()
 	contextDirectory = filepath.Join(home, ".kube-tray", "contexts")
-	if _, err := os.Stat(contextDirectory); !os.IsNotExist(err) {
-		if clean {
-			os.RemoveAll(contextDirectory)
+	existingContext = []string{}
+	rawKubeconfig := LoadRawKubeconfig()
+	loadedKubeconfig, invalidContexts = SplitRawKubeconfig(rawKubeconfig)
+	currentContext = rawKubeconfig.CurrentContext
+	if clean {
+		os.RemoveAll(contextDirectory)
+	}
+	os.MkdirAll(contextDirectory, 0755)
+
+	for ctx := range loadedKubeconfig {
+		if _, err := os.Stat(filepath.Join(contextDirectory, ctx)); err == nil {
+			existingContext = append(existingContext, ctx)
+			kubeLog.Infof("Loaded kubeconfig [%s]", ctx)
+		}
+	}
+}
+
+// ReloadChangedKubeconfig re-reads the default kubeconfig and returns the contexts that were added, changed or removed
+func ReloadChangedKubeconfig() ([]string, []string) {
+	kubeconfigMutex.Lock()
+	defer kubeconfigMutex.Unlock()
+
+	rawKubeconfig := LoadRawKubeconfig()
+	newKubeconfig, newInvalidContexts := SplitRawKubeconfig(rawKubeconfig)
+	invalidContexts = newInvalidContexts
+	currentContext = rawKubeconfig.CurrentContext
+	changed := []string{}
+	removed := []string{}
+	for ctx, newConfig := range newKubeconfig {
+		oldConfig, ok := loadedKubeconfig[ctx]
+		if ok && equality.Semantic.DeepEqual(oldConfig, newConfig) {
+			continue
+		}
+		if ok {
+			kubeLog.Infof("Kubeconfig context changed [%s]", ctx)
 		} else {
-			matches, _ := filepath.Glob(filepath.Join(contextDirectory, "*"))
-			for _, match := range matches {
-				ctx := filepath.Base(match)
-				existingContext = append(existingContext, ctx)
-				kubeLog.Infof("Loaded kubeconfig [%s]", ctx)
+			kubeLog.Infof("Kubeconfig context added [%s]", ctx)
+		}
+		changed = append(changed, ctx)
+	}
+	for ctx := range loadedKubeconfig {
+		if _, ok := newKubeconfig[ctx]; ok {
+			continue
+		}
+		kubeLog.Infof("Kubeconfig context removed [%s]", ctx)
+		removed = append(removed, ctx)
+		removeExistingContext(ctx)
+		os.RemoveAll(filepath.Join(contextDirectory, ctx))
+	}
+	loadedKubeconfig = newKubeconfig
+	return changed, removed
+}
+
+// GetContextKubeconfig returns the standalone kubeconfig of the context
+func GetContextKubeconfig(ctx string) (*clientcmdapi.Config, bool) {
+	kubeconfigMutex.Lock()
+	defer kubeconfigMutex.Unlock()
+
+	config, ok := loadedKubeconfig[ctx]
+	if !ok {
+		return nil, false
+	}
+	return config.DeepCopy(), true
+}
+
+// GetSiblingNamespaces returns the namespaces of the other contexts on the same cluster server, the default namespace
+// first, as candidates to probe for a context whose namespaces can't be listed
+func GetSiblingNamespaces(ctx string) []string {
+	kubeconfigMutex.Lock()
+	server := getContextServer(ctx)
+	siblings := map[string]string{}
+	for sibling, config := range loadedKubeconfig {
+		if server != "" && sibling != ctx && getContextServer(sibling) == server {
+			siblings[sibling] = config.Contexts[sibling].Namespace
+		}
+	}
+	kubeconfigMutex.Unlock()
+
+	names := []string{}
+	for sibling := range siblings {
+		names = append(names, sibling)
+	}
+	sort.Strings(names)
+	namespaces := []string{metav1.NamespaceDefault}
+	seen := map[string]bool{metav1.NamespaceDefault: true}
+	for _, sibling := range names {
+		for _, ns := range append([]string{siblings[sibling]}, GetContextNamespaces(sibling)...) {
+			if ns != "" && !seen[ns] {
+				seen[ns] = true
+				namespaces = append(namespaces, ns)
 			}
-			return
 		}
-	} else {
-		os.MkdirAll(contextDirectory, 0755)
 	}
+	return namespaces
+}
+
+func getContextServer(ctx string) string {
+	config, ok := loadedKubeconfig[ctx]
+	if !ok {
+		return ""
+	}
+	cluster, ok := config.Clusters[config.Contexts[ctx].Cluster]
+	if !ok {
+		return ""
+	}
+	return cluster.Server
+}
+
+// GetInvalidContexts returns the contexts with dangling references and the reason they can't be used
+func GetInvalidContexts() map[string]string {
+	kubeconfigMutex.Lock()
+	defer kubeconfigMutex.Unlock()
+
+	invalid := map[string]string{}
+	for ctx, reason := range invalidContexts {
+		invalid[ctx] = reason
+	}
+	return invalid
+}
+
+// GetCurrentContextName returns current-context of the default kubeconfig
+func GetCurrentContextName() string {
+	kubeconfigMutex.Lock()
+	defer kubeconfigMutex.Unlock()
+	return currentContext
+}
+
+func setCurrentContextName(ctx string) {
+	kubeconfigMutex.Lock()
+	defer kubeconfigMutex.Unlock()
+	currentContext = ctx
+}
 
+// GetKubeconfigContexts returns all contexts of the default kubeconfig
+func GetKubeconfigContexts() []string {
+	kubeconfigMutex.Lock()
+	defer kubeconfigMutex.Unlock()
+
+	ctxs := []string{}
+	for ctx := range loadedKubeconfig {
+		ctxs = append(ctxs, ctx)
+	}
+	return ctxs
+}
+
+func LoadRawKubeconfig() clientcmdapi.Config {
 	// Load default kubeconfig
 	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
 	configOverrides := &clientcmd.ConfigOverrides{}
@@ -36,28 +186,127 @@ func LoadKubeconfig(clean bool) {
 	if err != nil {
 		kubeLog.Warning(err)
 	}
+	return config
+}
 
-	// Create seperate kubeconfig per context
-	for ctx := range config.Contexts {
-		user := config.Contexts[ctx].AuthInfo
-		newConfig := *clientcmdapi.NewConfig()
-		newConfig.Contexts[ctx] = config.Contexts[ctx].DeepCopy()
-		newConfig.AuthInfos[user] = config.AuthInfos[user].DeepCopy()
-		newConfig.Clusters[ctx] = config.Clusters[ctx].DeepCopy()
+// SplitRawKubeconfig creates a standalone kubeconfig for each context, contexts with dangling references
+// are returned apart with the reason
+func SplitRawKubeconfig(config clientcmdapi.Config) (map[string]*clientcmdapi.Config, map[string]string) {
+	splitConfigs := map[string]*clientcmdapi.Config{}
+	invalid := map[string]string{}
+	for ctx, context := range config.Contexts {
+		if context == nil {
+			continue
+		}
+		cluster, ok := config.Clusters[context.Cluster]
+		if !ok || cluster == nil {
+			invalid[ctx] = fmt.Sprintf("references missing cluster %q", context.Cluster)
+			kubeLog.Warningf("Context [%s] %s", ctx, invalid[ctx])
+			continue
+		}
+		newConfig := clientcmdapi.NewConfig()
+		newConfig.Contexts[ctx] = context.DeepCopy()
+		newConfig.Clusters[context.Cluster] = cluster.DeepCopy()
+		// Contexts without user rely on in-cluster or anonymous access
+		if context.AuthInfo != "" {
+			authInfo, ok := config.AuthInfos[context.AuthInfo]
+			if !ok || authInfo == nil {
+				invalid[ctx] = fmt.Sprintf("references missing user %q", context.AuthInfo)
+				kubeLog.Warningf("Context [%s] %s", ctx, invalid[ctx])
+				continue
+			}
+			newConfig.AuthInfos[context.AuthInfo] = authInfo.DeepCopy()
+		}
+		config.Preferences.DeepCopyInto(&newConfig.Preferences)
+		for name, extension := range config.Extensions {
+			if extension != nil {
+				newConfig.Extensions[name] = extension.DeepCopyObject()
+			}
+		}
 		newConfig.CurrentContext = ctx
-		tmpConfigPath := filepath.Join(contextDirectory, (ctx + "tmp"))
-		clientcmd.WriteToFile(newConfig, tmpConfigPath)
-		namespaces := GetNamespaces(tmpConfigPath)
-		os.Remove(tmpConfigPath)
+		splitConfigs[ctx] = newConfig
+	}
+	return splitConfigs, invalid
+}
 
-		if len(namespaces) > 0 {
-			existingContext = append(existingContext, ctx)
-			kubeLog.Infof("(Re)Created kubeconfig [%s]", ctx)
-			for _, nsItem := range namespaces {
-				ns := nsItem.Name
-				newConfig.Contexts[ctx].Namespace = ns
-				clientcmd.WriteToFile(newConfig, filepath.Join(contextDirectory, ctx, ns))
-			}
+// SaveContextData writes the kubeconfigs of a successful refresh, returns false without writing when the context
+// was changed or removed since it was fetched, results without kubeconfig only update the existing contexts.
+// Writes are serialized with the reloads of the kubeconfig removing the directories of contexts
+func SaveContextData(data ContextData) bool {
+	kubeconfigMutex.Lock()
+	defer kubeconfigMutex.Unlock()
+
+	if data.Kubeconfig != nil {
+		config, ok := loadedKubeconfig[data.Context]
+		if !ok || !equality.Semantic.DeepEqual(config, data.Kubeconfig) {
+			return false
+		}
+		WriteContextKubeconfig(data.Context, data.Kubeconfig, data.Namespaces, data.Source)
+	}
+	if len(data.Namespaces) > 0 {
+		addExistingContext(data.Context)
+	} else {
+		removeExistingContext(data.Context)
+	}
+	return true
+}
+
+// WriteContextKubeconfig replaces the kubeconfigs of the context with one kubeconfig per namespace
+func WriteContextKubeconfig(ctx string, newConfig *clientcmdapi.Config, namespaces []string, source string) {
+	os.RemoveAll(filepath.Join(contextDirectory, ctx))
+	if len(namespaces) == 0 {
+		return
+	}
+	kubeLog.Infof("(Re)Created kubeconfig [%s]", ctx)
+	nsConfig := newConfig.DeepCopy()
+	for _, ns := range namespaces {
+		nsConfig.Contexts[ctx].Namespace = ns
+		clientcmd.WriteToFile(*nsConfig, filepath.Join(contextDirectory, ctx, ns))
+	}
+	if source != NamespaceSourceList {
+		kubeLog.Infof("Namespaces of [%s] %s", ctx, source)
+		os.WriteFile(filepath.Join(contextDirectory, ctx, namespaceSourceFile), []byte(source), 0644)
+	}
+}
+
+// ReadNamespaceSource returns how the namespaces of the context were resolved
+func ReadNamespaceSource(ctx string) string {
+	source, err := os.ReadFile(filepath.Join(contextDirectory, ctx, namespaceSourceFile))
+	if err != nil {
+		return NamespaceSourceList
+	}
+	return string(source)
+}
+
+// ReadContextNamespaces returns the namespaces of the context written by the last successful refresh
+func ReadContextNamespaces(ctx string) []string {
+	namespaces := []string{}
+	matches, _ := filepath.Glob(filepath.Join(contextDirectory, ctx, "*"))
+	for _, match := range matches {
+		ns := filepath.Base(match)
+		// Skip metadata files, namespaces never start with a dot
+		if strings.HasPrefix(ns, ".") {
+			continue
+		}
+		namespaces = append(namespaces, ns)
+	}
+	return namespaces
+}
+
+func addExistingContext(ctx string) {
+	for _, existing := range existingContext {
+		if existing == ctx {
+			return
+		}
+	}
+	existingContext = append(existingContext, ctx)
+}
+
+func removeExistingContext(ctx string) {
+	for i, existing := range existingContext {
+		if existing == ctx {
+			existingContext = append(existingContext[:i], existingContext[i+1:]...)
+			return
 		}
 	}
 }
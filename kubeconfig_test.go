@@ -0,0 +1,122 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+func TestSplitRawKubeconfig(t *testing.T) {
+	tests := []struct {
+		file    string
+		valid   map[string][]string
+		invalid map[string]string
+	}{
+		{
+			file: "valid.yaml",
+			// Context mapped to its cluster and user
+			valid: map[string][]string{
+				"kind-a": {"kind-a", "kind-a"},
+				"kind-b": {"kind-b", "kind-b"},
+			},
+			invalid: map[string]string{},
+		},
+		{
+			file:    "missing-cluster.yaml",
+			valid:   map[string][]string{"kind-a": {"kind-a", "kind-a"}},
+			invalid: map[string]string{"deleted-cluster": `references missing cluster "deleted"`},
+		},
+		{
+			file:    "missing-user.yaml",
+			valid:   map[string][]string{"kind-a": {"kind-a", "kind-a"}},
+			invalid: map[string]string{"expired": `references missing user "logged-out"`},
+		},
+		{
+			file:    "no-user.yaml",
+			valid:   map[string][]string{"in-cluster": {"local", ""}},
+			invalid: map[string]string{},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.file, func(t *testing.T) {
+			raw, err := clientcmd.LoadFromFile(filepath.Join("testdata", "kubeconfig", test.file))
+			if err != nil {
+				t.Fatal(err)
+			}
+			split, invalid := SplitRawKubeconfig(*raw)
+			if !reflect.DeepEqual(invalid, test.invalid) {
+				t.Errorf("invalid = %v, want %v", invalid, test.invalid)
+			}
+			if len(split) != len(test.valid) {
+				t.Errorf("split %d contexts, want %d", len(split), len(test.valid))
+			}
+			for ctx, refs := range test.valid {
+				config, ok := split[ctx]
+				if !ok {
+					t.Errorf("context %s was not split", ctx)
+					continue
+				}
+				if config.CurrentContext != ctx {
+					t.Errorf("%s: current-context = %s", ctx, config.CurrentContext)
+				}
+				if len(config.Contexts) != 1 || config.Contexts[ctx].Cluster != refs[0] || config.Contexts[ctx].AuthInfo != refs[1] {
+					t.Errorf("%s: contexts = %v", ctx, config.Contexts)
+				}
+				if _, ok := config.Clusters[refs[0]]; !ok || len(config.Clusters) != 1 {
+					t.Errorf("%s: %d clusters, want only %s", ctx, len(config.Clusters), refs[0])
+				}
+				if refs[1] == "" && len(config.AuthInfos) != 0 {
+					t.Errorf("%s: %d users, want none", ctx, len(config.AuthInfos))
+				}
+				if _, ok := config.AuthInfos[refs[1]]; refs[1] != "" && (!ok || len(config.AuthInfos) != 1) {
+					t.Errorf("%s: %d users, want only %s", ctx, len(config.AuthInfos), refs[1])
+				}
+			}
+		})
+	}
+}
+
+func TestInvalidContextIsShownDisabled(t *testing.T) {
+	setupTestHome(t)
+	content, err := os.ReadFile(filepath.Join("testdata", "kubeconfig", "missing-cluster.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := os.Getenv("KUBECONFIG")
+	os.MkdirAll(filepath.Dir(path), 0755)
+	if err := os.WriteFile(path, content, 0600); err != nil {
+		t.Fatal(err)
+	}
+	LoadKubeconfig(false)
+	root, menu := newTestRoot(t)
+	root.LoadCachedData()
+
+	item := menu.Find("deleted-cluster (invalid)")
+	assertVisible(t, item, true, "deleted-cluster (invalid)")
+	if !item.Disabled() {
+		t.Error("invalid context is not disabled")
+	}
+	if want := `deleted-cluster: references missing cluster "deleted"`; item.Tooltip() != want {
+		t.Errorf("tooltip = %q, want %q", item.Tooltip(), want)
+	}
+
+	// Fixing the reference enables the context again
+	writeTestKubeconfig(t, newTestKubeconfig("kind-a", "deleted-cluster"))
+	NewClientset = func(*clientcmdapi.Config) (kubernetes.Interface, error) {
+		return newFakeClientset("default"), nil
+	}
+	t.Cleanup(func() {
+		NewClientset = newClientset
+	})
+	onKubeconfigChanged()
+	item = menu.Find("deleted-cluster")
+	assertVisible(t, item, true, "deleted-cluster")
+	if item.Disabled() {
+		t.Error("fixed context is still disabled")
+	}
+}
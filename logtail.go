@@ -0,0 +1,291 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	rotatelogs "github.com/lestrrat-go/file-rotatelogs"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/homedir"
+)
+
+const (
+	logTailReconnectDelay = 3 * time.Second
+	logTailMaxAge         = 7 * 24 * time.Hour
+)
+
+// LogTailSpec describes the container whose logs are tailed
+type LogTailSpec struct {
+	Context   string
+	Namespace string
+	Pod       string
+	Container string
+}
+
+// LogTail is a running log stream written to a rotating file, reconnecting until stopped
+type LogTail struct {
+	Spec   LogTailSpec
+	Status string
+	File   string
+	stopCh chan struct{}
+	// Only used by the tailing goroutine to follow replaced pods
+	pod       string
+	podLabels map[string]string
+}
+
+var (
+	logTailMutex   sync.Mutex
+	logTails       = map[string]*LogTail{}
+	logTailElement *Element
+)
+
+func (spec LogTailSpec) ID() string {
+	return fmt.Sprintf("%s | %s | pod/%s [%s]", spec.Context, spec.Namespace, spec.Pod, spec.Container)
+}
+
+func (tail *LogTail) String() string {
+	return fmt.Sprintf("%s (%s)", tail.Spec.ID(), tail.Status)
+}
+
+// GetLogDirectory returns the directory holding the log files of the pod
+func GetLogDirectory(ctx string, ns string, pod string) string {
+	return filepath.Join(homedir.HomeDir(), ".kube-tray", "logs", ctx, ns, pod)
+}
+
+// StartLogTail starts streaming the container logs and opens the log file, an existing tail is only reopened
+func StartLogTail(spec LogTailSpec) error {
+	logTailMutex.Lock()
+	if tail, ok := logTails[spec.ID()]; ok {
+		file := tail.File
+		logTailMutex.Unlock()
+		return OpenLogFile(spec, file)
+	}
+	directory := GetLogDirectory(spec.Context, spec.Namespace, spec.Pod)
+	linkName := filepath.Join(directory, spec.Container+".log")
+	writer, err := rotatelogs.New(
+		filepath.Join(directory, spec.Container+".%Y%m%d.log"),
+		rotatelogs.WithLinkName(linkName),
+		rotatelogs.WithMaxAge(logTailMaxAge),
+	)
+	if err != nil {
+		logTailMutex.Unlock()
+		return err
+	}
+	// The first write creates the file and its link so the pager has something to open
+	if _, err := fmt.Fprintf(writer, "--- Tailing %s since %s\n", spec.ID(), time.Now().Format(time.RFC3339)); err != nil {
+		logTailMutex.Unlock()
+		writer.Close()
+		return err
+	}
+	file := linkName
+	if _, err := os.Lstat(linkName); err != nil {
+		// Symlinks may be unavailable, e.g. on Windows without developer mode
+		file = writer.CurrentFileName()
+	}
+	tail := &LogTail{Spec: spec, Status: "connecting", File: file, stopCh: make(chan struct{}), pod: spec.Pod}
+	logTails[spec.ID()] = tail
+	logTailMutex.Unlock()
+
+	kubeLog.Infof("Start log tail %s into %s", spec.ID(), file)
+	go tail.run(writer)
+	UpdateLogTailMenu()
+	return OpenLogFile(spec, file)
+}
+
+func StopLogTail(id string) {
+	logTailMutex.Lock()
+	tail, ok := logTails[id]
+	if ok {
+		delete(logTails, id)
+		close(tail.stopCh)
+	}
+	logTailMutex.Unlock()
+	if ok {
+		kubeLog.Infof("Stop log tail %s", id)
+		UpdateLogTailMenu()
+	}
+}
+
+func StopAllLogTails() {
+	for _, tail := range GetLogTails() {
+		StopLogTail(tail.Spec.ID())
+	}
+}
+
+// GetLogTails returns a snapshot of the running log tails sorted by ID
+func GetLogTails() []LogTail {
+	logTailMutex.Lock()
+	defer logTailMutex.Unlock()
+	tails := []LogTail{}
+	for _, tail := range logTails {
+		tails = append(tails, LogTail{Spec: tail.Spec, Status: tail.Status, File: tail.File})
+	}
+	sort.Slice(tails, func(i, j int) bool {
+		return tails[i].Spec.ID() < tails[j].Spec.ID()
+	})
+	return tails
+}
+
+// OpenLogFile opens the log file with the `logs.command` templates, {{.File}} is the file path
+func OpenLogFile(spec LogTailSpec, file string) error {
+	data := NewTerminalData(spec.Context, spec.Namespace)
+	data.Pod = spec.Pod
+	data.Container = spec.Container
+	data.File = file
+	return StartCommand(fmt.Sprintf("Logs of %s", spec.ID()), GetConfig().Logs.Command, data, GetContextSettings(spec.Context))
+}
+
+func (tail *LogTail) setStatus(status string) {
+	logTailMutex.Lock()
+	tail.Status = status
+	logTailMutex.Unlock()
+	UpdateLogTailMenu()
+}
+
+// run streams the logs and reconnects when the container restarts or the pod is replaced
+func (tail *LogTail) run(writer *rotatelogs.RotateLogs) {
+	defer writer.Close()
+	var since *metav1.Time
+	for {
+		err := tail.streamOnce(writer, since)
+		select {
+		case <-tail.stopCh:
+			fmt.Fprintf(writer, "--- Stopped at %s\n", time.Now().Format(time.RFC3339))
+			return
+		default:
+		}
+		// Resume from where the stream ended instead of replaying the tail lines
+		now := metav1.Now()
+		since = &now
+		if err != nil {
+			kubeLog.Warningf("Log tail %s: %s", tail.Spec.ID(), err)
+			fmt.Fprintf(writer, "--- Stream failed at %s: %s\n", now.Format(time.RFC3339), err)
+			tail.setStatus(fmt.Sprintf("retrying: %s", err))
+		} else {
+			kubeLog.Infof("Log tail %s ended, reconnecting", tail.Spec.ID())
+			fmt.Fprintf(writer, "--- Stream ended at %s, reconnecting\n", now.Format(time.RFC3339))
+			tail.setStatus("reconnecting")
+		}
+		select {
+		case <-tail.stopCh:
+			return
+		case <-time.After(logTailReconnectDelay):
+		}
+	}
+}
+
+func (tail *LogTail) streamOnce(writer io.Writer, since *metav1.Time) error {
+	config, ok := GetContextKubeconfig(tail.Spec.Context)
+	if !ok {
+		return fmt.Errorf("context %s not found", tail.Spec.Context)
+	}
+	clientset, err := NewClientset(config)
+	if err != nil {
+		return err
+	}
+	reqContext, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-tail.stopCh:
+			cancel()
+		case <-reqContext.Done():
+		}
+	}()
+
+	pod, err := tail.resolvePod(reqContext, clientset)
+	if err != nil {
+		return err
+	}
+	if pod.Name != tail.pod {
+		fmt.Fprintf(writer, "--- Following replacement pod %s\n", pod.Name)
+		tail.pod = pod.Name
+	}
+	options := &v1.PodLogOptions{Container: tail.Spec.Container, Follow: true}
+	if since != nil {
+		options.SinceTime = since
+	} else if lines := int64(GetConfig().Logs.TailLines); lines > 0 {
+		options.TailLines = &lines
+	}
+	stream, err := clientset.CoreV1().Pods(tail.Spec.Namespace).GetLogs(pod.Name, options).Stream(reqContext)
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+	tail.setStatus(fmt.Sprintf("pod/%s", pod.Name))
+	_, err = io.Copy(writer, stream)
+	return err
+}
+
+// resolvePod returns the tailed pod, or a running pod of the same workload once it was replaced
+func (tail *LogTail) resolvePod(reqContext context.Context, clientset kubernetes.Interface) (*v1.Pod, error) {
+	pod, err := clientset.CoreV1().Pods(tail.Spec.Namespace).Get(reqContext, tail.pod, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) && tail.podLabels != nil {
+		pod, err = findRunningPod(reqContext, clientset, tail.Spec.Namespace, tail.podLabels)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if tail.podLabels == nil {
+		tail.podLabels = workloadLabels(pod.Labels)
+	}
+	return pod, nil
+}
+
+// NewLogTailMenu creates the top level Log Tails menu
+func NewLogTailMenu(menu Menu) {
+	logTailElement = &Element{
+		Menu:     menu,
+		MenuItem: menu.AddMenuItem("Log Tails", "Active log tails"),
+		Children: map[string]*Element{},
+		Disposed: map[string]*Element{},
+		Updated:  true,
+		Locked:   true,
+	}
+	logTailElement.AddChild("Stop all", true).ChannelWaitForClick(StopAllLogTails)
+	separator := logTailElement.MenuItem.AddSubMenuItem("", "")
+	separator.Disable()
+	UpdateLogTailMenu()
+}
+
+// UpdateLogTailMenu lists the running log tails, each with Open and Stop actions
+func UpdateLogTailMenu() {
+	if logTailElement == nil {
+		return
+	}
+	logTailElement.mutex.Lock()
+	defer logTailElement.mutex.Unlock()
+
+	tails := GetLogTails()
+	logTailElement.MenuItem.SetTitle(fmt.Sprintf("Log Tails (%d)", len(tails)))
+	logTailElement.ElementTraversalMarkNonUpdated()
+	for _, tail := range tails {
+		id := tail.Spec.ID()
+		tailElement, ok := logTailElement.Children[id]
+		if ok {
+			tailElement.Updated = true
+		} else if tailElement, ok = logTailElement.Revive(id); !ok {
+			spec := tail.Spec
+			tailElement = logTailElement.AddChild(id, false)
+			tailElement.AddChild("Open", true).ChannelWaitForClick(func() {
+				if err := StartLogTail(spec); err != nil {
+					ShowLastError(fmt.Errorf("unable to open logs of %s: %w", id, err))
+				}
+			})
+			tailElement.AddChild("Stop", true).ChannelWaitForClick(func() {
+				StopLogTail(id)
+			})
+		}
+		tailElement.MenuItem.SetTitle(tail.String())
+	}
+	logTailElement.ElementTraversalDisposeNonUpdated()
+}
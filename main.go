@@ -1,17 +1,16 @@
 package main
 
 import (
+	"fmt"
 	"io"
+	"net/http"
 	"os"
 	"path/filepath"
-	"runtime"
+	"sync"
 	"time"
 
-	"github.com/getlantern/systray"
 	rotatelogs "github.com/lestrrat-go/file-rotatelogs"
 	log "github.com/sirupsen/logrus"
-	"github.com/solacens/kube-tray/icon"
-	"github.com/spf13/viper"
 	"k8s.io/client-go/util/homedir"
 )
 
@@ -19,10 +18,16 @@ var (
 	contextDirectory string
 	existingContext  []string
 
-	rootElement *Element
+	rootElement       *Element
+	lastErrorMenuItem MenuItem
 
-	autoRefresh bool
+	autoRefreshMutex    sync.Mutex
+	autoRefresh         bool
+	autoRefreshMenuItem MenuItem
 
+	configErr error
+
+	logFile io.Writer
 	trayLog *log.Entry
 	kubeLog *log.Entry
 )
@@ -34,6 +39,7 @@ func init() {
 	// Logger setting
 	logFilePath := filepath.Join(home, ".kube-tray", "log.")
 	r, _ := rotatelogs.New(logFilePath + "%Y%m%d")
+	logFile = r
 	mw := io.MultiWriter(os.Stdout, r)
 	log.SetFormatter(&log.JSONFormatter{})
 	log.SetOutput(mw)
@@ -44,82 +50,148 @@ func init() {
 	kubeLog = log.WithFields(log.Fields{
 		"type": "kube",
 	})
+}
+
+func main() {
+	if len(os.Args) > 1 {
+		// Keep the output of subcommands clean for scripts
+		log.SetOutput(logFile)
+		// The running tray may be saving config.yaml, read it without migrating
+		LoadConfig(false)
+		os.Exit(RunCLI(os.Args[1:], os.Stdout, os.Stderr))
+	}
 
-	// Config
-	viper.SetConfigName("config")
-	viper.SetConfigType("yaml")
-	viper.AddConfigPath(filepath.Join(home, ".kube-tray"))
-	err := viper.ReadInConfig()
+	// Taken before loading config.yaml, only the tray holding the lock writes it
+	runningPid, err := AcquireInstanceLock()
 	if err != nil {
-		// Default terminal command
-		if runtime.GOOS == "windows" {
-			// cmd /c wt -w 0 nt
-			viper.Set("shell.command", []string{"cmd", "/c", "wt", "-w", "0", "nt"})
-		} else {
-			// bash
-			viper.Set("shell.command", []string{"bash"}) // Untested: Darwin & Linux
+		fmt.Fprintf(os.Stderr, "kube-tray: %s\n", err)
+		os.Exit(1)
+	}
+	if runningPid != 0 {
+		LoadConfig(false)
+		// A launch of the running tray is taken as a request to refresh
+		fmt.Printf("kube-tray is already running (pid %d), refreshing it\n", runningPid)
+		if err := CallControl(http.MethodPost, "/refresh", ControlTarget{}, nil); err != nil {
+			fmt.Fprintf(os.Stderr, "kube-tray: %s\n", err)
+			os.Exit(1)
 		}
-		// Auto refresh
-		viper.Set("auto-refresh.enabled", false)
-		viper.Set("auto-refresh.interval", 3600)
-		// WriteConfigAs requried for first time creation
-		viper.WriteConfigAs(filepath.Join(home, ".kube-tray", "config.yaml"))
+		os.Exit(0)
 	}
+	LoadConfig(true)
+
+	LoadKubeconfig(false)
 
-	autoRefresh = viper.GetBool("auto-refresh.enabled")
+	RunTray(onTrayReady, ReleaseInstanceLock)
 }
 
-func main() {
-	LoadKubeconfig(false)
+// LoadConfig loads config.yaml, an error is kept to be shown once the tray is ready, only the owner of the instance
+// lock writes config.yaml
+func LoadConfig(owner bool) {
+	configErr = InitConfig(owner)
+	if configErr != nil {
+		trayLog.Error(configErr)
+	}
+	autoRefresh = GetConfig().AutoRefresh.Enabled
+	ApplyLogLevel()
+}
 
-	systray.Run(onTrayReady, func() {})
+func IsAutoRefresh() bool {
+	autoRefreshMutex.Lock()
+	defer autoRefreshMutex.Unlock()
+	return autoRefresh
 }
 
-func onTrayReady() {
-	systray.SetTemplateIcon(icon.Data, icon.Data)
-	systray.SetTitle("K8S Tray")
-	systray.SetTooltip("Kubernetes Tray")
+// SetAutoRefresh enables or disables auto refresh and saves it to the config
+func SetAutoRefresh(enabled bool) {
+	if enabled {
+		trayLog.Info("Enable auto refresh")
+	} else {
+		trayLog.Info("Disable auto refresh")
+	}
+	setAutoRefreshState(enabled)
+	if err := SaveConfigValue("auto-refresh.enabled", enabled); err != nil {
+		ShowLastError(err)
+	}
+}
+
+// setAutoRefreshState updates auto refresh and its checkbox without saving
+func setAutoRefreshState(enabled bool) {
+	autoRefreshMutex.Lock()
+	defer autoRefreshMutex.Unlock()
+	autoRefresh = enabled
+	if autoRefreshMenuItem == nil {
+		return
+	}
+	if enabled {
+		autoRefreshMenuItem.Check()
+	} else {
+		autoRefreshMenuItem.Uncheck()
+	}
+}
+
+// ReloadKubeconfig splits the kubeconfig from scratch and refreshes all contexts
+func ReloadKubeconfig() {
+	trayLog.Info("Reloading config")
+	LoadKubeconfig(true)
+	go rootElement.UpdateData()
+	UpdateEventWatches()
+	trayLog.Info("Reloaded")
+}
+
+// ShowLastError logs the error and shows it as a disabled item in the tray
+func ShowLastError(err error) {
+	trayLog.Error(err)
+	if lastErrorMenuItem == nil {
+		return
+	}
+	lastErrorMenuItem.SetTitle(fmt.Sprintf("Last error: %s", err))
+	lastErrorMenuItem.SetTooltip(err.Error())
+	lastErrorMenuItem.Show()
+}
+
+func onTrayReady(trayMenu Menu) {
 
 	//////////////////////////////////
-	quitMenuItem := systray.AddMenuItem("Quit", "Quit")
+	quitMenuItem := trayMenu.AddMenuItem("Quit", "Quit")
 
 	//////////////////////////////////
-	systray.AddSeparator()
+	trayMenu.AddSeparator()
 
 	//////////////////////////////////
-	reloadMenuItem := systray.AddMenuItem("Reload Kubeconfig", "Reload Kubeconfig")
-	reloadMenuItemFunc := func() {
-		trayLog.Info("Reloading config")
-		LoadKubeconfig(true)
-		go rootElement.UpdateData()
-		trayLog.Info("Reloaded")
+	lastErrorMenuItem = trayMenu.AddMenuItem("", "Last error")
+	lastErrorMenuItem.Disable()
+	lastErrorMenuItem.Hide()
+	if configErr != nil {
+		ShowLastError(configErr)
 	}
 
 	//////////////////////////////////
-	autoRefreshMenuItem := systray.AddMenuItemCheckbox("Auto Refresh", "Auto Refresh", autoRefresh)
-	autoRefreshTicker := time.NewTicker(viper.GetDuration("auto-refresh.interval") * time.Second)
+	reloadMenuItem := trayMenu.AddMenuItem("Reload Kubeconfig", "Reload Kubeconfig")
+	reloadMenuItemFunc := ReloadKubeconfig
+
+	//////////////////////////////////
+	autoRefreshMenuItem = trayMenu.AddMenuItemCheckbox("Auto Refresh", "Auto Refresh", IsAutoRefresh())
+	autoRefreshTicker := time.NewTicker(GetConfig().AutoRefresh.Interval.Duration())
 	autoRefreshMenuItemFunc := func() {
-		if autoRefreshMenuItem.Checked() {
-			trayLog.Info("Disable auto refresh")
-			autoRefresh = false
-			viper.Set("auto-refresh.enabled", false)
-			viper.WriteConfig()
-			autoRefreshMenuItem.Uncheck()
-		} else {
-			trayLog.Info("Enable auto refresh")
-			autoRefresh = true
-			viper.Set("auto-refresh.enabled", true)
-			viper.WriteConfig()
-			autoRefreshMenuItem.Check()
-		}
+		SetAutoRefresh(!IsAutoRefresh())
 	}
 
 	//////////////////////////////////
-	systray.AddSeparator()
+	NewPortForwardMenu(trayMenu)
+	NewLogTailMenu(trayMenu)
+
+	//////////////////////////////////
+	trayMenu.AddSeparator()
 
 	//////////////////////////////////
-	rootElement = NewRoot()
+	rootElement = NewRoot(trayMenu)
+	rootElement.LoadCachedData()
 	go rootElement.UpdateData()
+	WatchKubeconfig()
+	notifier = NewThrottledNotifier(NewDesktopNotifier(), GetConfig().Events.DedupeWindow.Duration(), GetConfig().Events.RateLimit)
+	UpdateEventWatches()
+	StartControlServer()
+	WatchConfig()
 
 	//////////////////////////////////
 	trayLog.Info("Ready")
@@ -127,17 +199,22 @@ func onTrayReady() {
 	//////////////////////////////////
 	for {
 		select {
-		case <-quitMenuItem.ClickedCh:
+		case <-quitMenuItem.ClickedCh():
 			trayLog.Info("Quit")
-			systray.Quit()
-		case <-reloadMenuItem.ClickedCh:
+			StopAllPortForwards()
+			StopAllLogTails()
+			StopControlServer()
+			QuitTray()
+		case <-reloadMenuItem.ClickedCh():
 			reloadMenuItemFunc()
 		case <-autoRefreshTicker.C:
-			if autoRefresh {
+			if IsAutoRefresh() {
 				go rootElement.UpdateData()
 			}
-		case <-autoRefreshMenuItem.ClickedCh:
+		case <-autoRefreshMenuItem.ClickedCh():
 			autoRefreshMenuItemFunc()
+		case <-configReloadedCh:
+			autoRefreshTicker.Reset(GetConfig().AutoRefresh.Interval.Duration())
 		}
 	}
 }
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+func TestMain(m *testing.M) {
+	// Keep the log of the tests out of the output and the log file
+	log.SetOutput(io.Discard)
+	os.Exit(m.Run())
+}
+
+// setupTestHome points the home directory and the default kubeconfig to a temporary directory
+func setupTestHome(t *testing.T) string {
+	t.Helper()
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("USERPROFILE", home)
+	t.Setenv("KUBECONFIG", filepath.Join(home, ".kube", "config"))
+	return home
+}
+
+// newTestKubeconfig returns a kubeconfig with a cluster and user per context
+func newTestKubeconfig(ctxs ...string) *clientcmdapi.Config {
+	config := clientcmdapi.NewConfig()
+	for _, ctx := range ctxs {
+		config.Clusters[ctx] = &clientcmdapi.Cluster{Server: fmt.Sprintf("https://%s.invalid", ctx)}
+		config.AuthInfos[ctx] = &clientcmdapi.AuthInfo{Token: ctx}
+		config.Contexts[ctx] = &clientcmdapi.Context{Cluster: ctx, AuthInfo: ctx}
+	}
+	if len(ctxs) > 0 {
+		config.CurrentContext = ctxs[0]
+	}
+	return config
+}
+
+func writeTestKubeconfig(t *testing.T, config *clientcmdapi.Config) {
+	t.Helper()
+	path := os.Getenv("KUBECONFIG")
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := clientcmd.WriteToFile(*config, path); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// setupTestClusters loads a kubeconfig of the contexts, each served by its fake clientset
+func setupTestClusters(t *testing.T, clientsets map[string]kubernetes.Interface) {
+	t.Helper()
+	setupTestHome(t)
+	ctxs := []string{}
+	for ctx := range clientsets {
+		ctxs = append(ctxs, ctx)
+	}
+	// The first context is the current one
+	sort.Strings(ctxs)
+	writeTestKubeconfig(t, newTestKubeconfig(ctxs...))
+	LoadKubeconfig(false)
+	t.Cleanup(func() {
+		NewClientset = newClientset
+	})
+	NewClientset = func(config *clientcmdapi.Config) (kubernetes.Interface, error) {
+		clientset, ok := clientsets[config.CurrentContext]
+		if !ok {
+			return nil, fmt.Errorf("no fake clientset for %s", config.CurrentContext)
+		}
+		return clientset, nil
+	}
+}
+
+// setupTestConfig changes the config in use for the test, starting from the defaults
+func setupTestConfig(t *testing.T, change func(config *Config)) {
+	t.Helper()
+	config := DefaultConfig()
+	change(&config)
+	configMutex.Lock()
+	previous := currentConfig
+	currentConfig = config
+	configMutex.Unlock()
+	t.Cleanup(func() {
+		configMutex.Lock()
+		currentConfig = previous
+		configMutex.Unlock()
+	})
+}
@@ -0,0 +1,27 @@
+package main
+
+// Menu creates the top level items of the tray menu
+type Menu interface {
+	AddMenuItem(title string, tooltip string) MenuItem
+	AddMenuItemCheckbox(title string, tooltip string, checked bool) MenuItem
+	AddSeparator()
+	SetIcon(iconBytes []byte)
+	SetTooltip(tooltip string)
+}
+
+// MenuItem is a single entry of the tray menu
+type MenuItem interface {
+	AddSubMenuItem(title string, tooltip string) MenuItem
+	AddSubMenuItemCheckbox(title string, tooltip string, checked bool) MenuItem
+	ClickedCh() chan struct{}
+	SetTitle(title string)
+	SetTooltip(tooltip string)
+	Disabled() bool
+	Enable()
+	Disable()
+	Hide()
+	Show()
+	Checked() bool
+	Check()
+	Uncheck()
+}
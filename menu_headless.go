@@ -0,0 +1,18 @@
+//go:build headless
+// +build headless
+
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+// RunTray fails as headless builds have no system tray, only the subcommands are available
+func RunTray(onReady func(menu Menu), onExit func()) {
+	fmt.Fprintln(os.Stderr, "kube-tray: built without tray (headless), run kube-tray help for the subcommands")
+	onExit()
+	os.Exit(1)
+}
+
+func QuitTray() {}
@@ -0,0 +1,231 @@
+package main
+
+import "sync"
+
+// MemoryMenu is an in-memory Menu recording the state of its items, used where no tray is available
+type MemoryMenu struct {
+	mutex   sync.Mutex
+	items   []*MemoryMenuItem
+	icon    []byte
+	tooltip string
+}
+
+// MemoryMenuItem records title, tooltip, visibility, checked and disabled state of an item
+type MemoryMenuItem struct {
+	mutex     sync.Mutex
+	title     string
+	tooltip   string
+	hidden    bool
+	checked   bool
+	disabled  bool
+	checkbox  bool
+	children  []*MemoryMenuItem
+	clickedCh chan struct{}
+}
+
+func NewMemoryMenu() *MemoryMenu {
+	return &MemoryMenu{}
+}
+
+func newMemoryMenuItem(title string, tooltip string, checkbox bool, checked bool) *MemoryMenuItem {
+	return &MemoryMenuItem{
+		title:     title,
+		tooltip:   tooltip,
+		checkbox:  checkbox,
+		checked:   checked,
+		clickedCh: make(chan struct{}),
+	}
+}
+
+func (m *MemoryMenu) AddMenuItem(title string, tooltip string) MenuItem {
+	return m.add(newMemoryMenuItem(title, tooltip, false, false))
+}
+
+func (m *MemoryMenu) AddMenuItemCheckbox(title string, tooltip string, checked bool) MenuItem {
+	return m.add(newMemoryMenuItem(title, tooltip, true, checked))
+}
+
+func (m *MemoryMenu) AddSeparator() {
+	separator := newMemoryMenuItem("", "", false, false)
+	separator.disabled = true
+	m.add(separator)
+}
+
+func (m *MemoryMenu) SetIcon(iconBytes []byte) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.icon = iconBytes
+}
+
+func (m *MemoryMenu) Icon() []byte {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return m.icon
+}
+
+func (m *MemoryMenu) SetTooltip(tooltip string) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.tooltip = tooltip
+}
+
+func (m *MemoryMenu) Tooltip() string {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return m.tooltip
+}
+
+func (m *MemoryMenu) add(item *MemoryMenuItem) *MemoryMenuItem {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.items = append(m.items, item)
+	return item
+}
+
+// Items returns the top level items in insertion order
+func (m *MemoryMenu) Items() []*MemoryMenuItem {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return append([]*MemoryMenuItem{}, m.items...)
+}
+
+// Find returns the visible or hidden item following the path of titles
+func (m *MemoryMenu) Find(titles ...string) *MemoryMenuItem {
+	return findMemoryMenuItem(m.Items(), titles)
+}
+
+func findMemoryMenuItem(items []*MemoryMenuItem, titles []string) *MemoryMenuItem {
+	if len(titles) == 0 {
+		return nil
+	}
+	for _, item := range items {
+		if item.Title() != titles[0] {
+			continue
+		}
+		if len(titles) == 1 {
+			return item
+		}
+		return findMemoryMenuItem(item.Children(), titles[1:])
+	}
+	return nil
+}
+
+func (i *MemoryMenuItem) AddSubMenuItem(title string, tooltip string) MenuItem {
+	return i.add(newMemoryMenuItem(title, tooltip, false, false))
+}
+
+func (i *MemoryMenuItem) AddSubMenuItemCheckbox(title string, tooltip string, checked bool) MenuItem {
+	return i.add(newMemoryMenuItem(title, tooltip, true, checked))
+}
+
+func (i *MemoryMenuItem) add(item *MemoryMenuItem) *MemoryMenuItem {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+	i.children = append(i.children, item)
+	return item
+}
+
+// Children returns the sub menu items in insertion order
+func (i *MemoryMenuItem) Children() []*MemoryMenuItem {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+	return append([]*MemoryMenuItem{}, i.children...)
+}
+
+// Find returns the sub menu item following the path of titles
+func (i *MemoryMenuItem) Find(titles ...string) *MemoryMenuItem {
+	return findMemoryMenuItem(i.Children(), titles)
+}
+
+// Click simulates a click, blocking until the item's listener receives it
+func (i *MemoryMenuItem) Click() {
+	i.clickedCh <- struct{}{}
+}
+
+func (i *MemoryMenuItem) ClickedCh() chan struct{} {
+	return i.clickedCh
+}
+
+func (i *MemoryMenuItem) Title() string {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+	return i.title
+}
+
+func (i *MemoryMenuItem) SetTitle(title string) {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+	i.title = title
+}
+
+func (i *MemoryMenuItem) Tooltip() string {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+	return i.tooltip
+}
+
+func (i *MemoryMenuItem) SetTooltip(tooltip string) {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+	i.tooltip = tooltip
+}
+
+func (i *MemoryMenuItem) Disabled() bool {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+	return i.disabled
+}
+
+func (i *MemoryMenuItem) Enable() {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+	i.disabled = false
+}
+
+func (i *MemoryMenuItem) Disable() {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+	i.disabled = true
+}
+
+func (i *MemoryMenuItem) Visible() bool {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+	return !i.hidden
+}
+
+func (i *MemoryMenuItem) Hide() {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+	i.hidden = true
+}
+
+func (i *MemoryMenuItem) Show() {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+	i.hidden = false
+}
+
+func (i *MemoryMenuItem) Checkbox() bool {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+	return i.checkbox
+}
+
+func (i *MemoryMenuItem) Checked() bool {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+	return i.checked
+}
+
+func (i *MemoryMenuItem) Check() {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+	i.checked = true
+}
+
+func (i *MemoryMenuItem) Uncheck() {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+	i.checked = false
+}
@@ -0,0 +1,102 @@
+//go:build !headless
+// +build !headless
+
+package main
+
+import (
+	"github.com/getlantern/systray"
+	"github.com/solacens/kube-tray/icon"
+)
+
+// RunTray runs the system tray until QuitTray, onReady receives the tray menu
+func RunTray(onReady func(menu Menu), onExit func()) {
+	systray.Run(func() {
+		systray.SetTemplateIcon(icon.Data, icon.Data)
+		systray.SetTitle("K8S Tray")
+		systray.SetTooltip(defaultTrayTitle)
+		onReady(systrayMenu{})
+	}, onExit)
+}
+
+func QuitTray() {
+	systray.Quit()
+}
+
+// systrayMenu is the Menu backed by the system tray
+type systrayMenu struct{}
+
+type systrayMenuItem struct {
+	item *systray.MenuItem
+}
+
+func (systrayMenu) AddMenuItem(title string, tooltip string) MenuItem {
+	return systrayMenuItem{item: systray.AddMenuItem(title, tooltip)}
+}
+
+func (systrayMenu) AddMenuItemCheckbox(title string, tooltip string, checked bool) MenuItem {
+	return systrayMenuItem{item: systray.AddMenuItemCheckbox(title, tooltip, checked)}
+}
+
+func (systrayMenu) AddSeparator() {
+	systray.AddSeparator()
+}
+
+func (systrayMenu) SetIcon(iconBytes []byte) {
+	systray.SetIcon(iconBytes)
+}
+
+func (systrayMenu) SetTooltip(tooltip string) {
+	systray.SetTooltip(tooltip)
+}
+
+func (i systrayMenuItem) AddSubMenuItem(title string, tooltip string) MenuItem {
+	return systrayMenuItem{item: i.item.AddSubMenuItem(title, tooltip)}
+}
+
+func (i systrayMenuItem) AddSubMenuItemCheckbox(title string, tooltip string, checked bool) MenuItem {
+	return systrayMenuItem{item: i.item.AddSubMenuItemCheckbox(title, tooltip, checked)}
+}
+
+func (i systrayMenuItem) ClickedCh() chan struct{} {
+	return i.item.ClickedCh
+}
+
+func (i systrayMenuItem) SetTitle(title string) {
+	i.item.SetTitle(title)
+}
+
+func (i systrayMenuItem) SetTooltip(tooltip string) {
+	i.item.SetTooltip(tooltip)
+}
+
+func (i systrayMenuItem) Disabled() bool {
+	return i.item.Disabled()
+}
+
+func (i systrayMenuItem) Enable() {
+	i.item.Enable()
+}
+
+func (i systrayMenuItem) Disable() {
+	i.item.Disable()
+}
+
+func (i systrayMenuItem) Hide() {
+	i.item.Hide()
+}
+
+func (i systrayMenuItem) Show() {
+	i.item.Show()
+}
+
+func (i systrayMenuItem) Checked() bool {
+	return i.item.Checked()
+}
+
+func (i systrayMenuItem) Check() {
+	i.item.Check()
+}
+
+func (i systrayMenuItem) Uncheck() {
+	i.item.Uncheck()
+}
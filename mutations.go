@@ -0,0 +1,140 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+)
+
+const confirmTimeout = 5 * time.Second
+
+// IsProtectedContext tells whether mutations on the context need a confirmation, set by `protected` in the context settings
+func IsProtectedContext(ctx string) bool {
+	protected := GetContextSettings(ctx).Protected
+	return protected != nil && *protected
+}
+
+// RestartPatch is the strategic merge patch used by kubectl rollout restart
+func RestartPatch(now time.Time) []byte {
+	return []byte(fmt.Sprintf(`{"spec":{"template":{"metadata":{"annotations":{"kubectl.kubernetes.io/restartedAt":"%s"}}}}}`, now.Format(time.RFC3339)))
+}
+
+// ScalePatch is the merge patch sent to the scale subresource
+func ScalePatch(replicas int32) []byte {
+	return []byte(fmt.Sprintf(`{"spec":{"replicas":%d}}`, replicas))
+}
+
+func RolloutRestart(reqContext context.Context, clientset kubernetes.Interface, ns string, workload Workload, now time.Time) error {
+	patch := RestartPatch(now)
+	var err error
+	switch workload.Kind {
+	case WorkloadDeployment:
+		_, err = clientset.AppsV1().Deployments(ns).Patch(reqContext, workload.Name, types.StrategicMergePatchType, patch, metav1.PatchOptions{})
+	case WorkloadStatefulSet:
+		_, err = clientset.AppsV1().StatefulSets(ns).Patch(reqContext, workload.Name, types.StrategicMergePatchType, patch, metav1.PatchOptions{})
+	case WorkloadDaemonSet:
+		_, err = clientset.AppsV1().DaemonSets(ns).Patch(reqContext, workload.Name, types.StrategicMergePatchType, patch, metav1.PatchOptions{})
+	default:
+		err = fmt.Errorf("unable to restart %s", workload.Kind)
+	}
+	return err
+}
+
+func ScaleWorkload(reqContext context.Context, clientset kubernetes.Interface, ns string, workload Workload, replicas int32) error {
+	patch := ScalePatch(replicas)
+	var err error
+	switch workload.Kind {
+	case WorkloadDeployment:
+		_, err = clientset.AppsV1().Deployments(ns).Patch(reqContext, workload.Name, types.MergePatchType, patch, metav1.PatchOptions{}, "scale")
+	case WorkloadStatefulSet:
+		_, err = clientset.AppsV1().StatefulSets(ns).Patch(reqContext, workload.Name, types.MergePatchType, patch, metav1.PatchOptions{}, "scale")
+	default:
+		err = fmt.Errorf("unable to scale %s", workload.Kind)
+	}
+	return err
+}
+
+// MutateWorkload runs the mutation against the context and writes it to the log
+func MutateWorkload(ctx string, ns string, workload Workload, operation string, mutate func(context.Context, kubernetes.Interface) error) error {
+	config, ok := GetContextKubeconfig(ctx)
+	if !ok {
+		return fmt.Errorf("context %s not found", ctx)
+	}
+	clientset, err := NewClientset(config)
+	if err != nil {
+		return err
+	}
+	reqContext, cancel := context.WithTimeout(context.Background(), GetConfig().AutoRefresh.Timeout.Duration())
+	defer cancel()
+
+	err = mutate(reqContext, clientset)
+	entry := kubeLog.WithFields(log.Fields{
+		"context":   ctx,
+		"namespace": ns,
+		"object":    workload.Key(),
+		"user":      config.Contexts[ctx].AuthInfo,
+	})
+	if err != nil {
+		entry.Warningf("%s failed: %s", operation, err)
+		return err
+	}
+	entry.Info(operation)
+	return nil
+}
+
+// AddWorkloadActions adds the rollout restart and scale actions to the workload submenu
+func (workloadElement *Element) AddWorkloadActions(ctx string, ns string, workload Workload) {
+	confirm := func() bool {
+		return IsProtectedContext(ctx)
+	}
+	workloadElement.AddChild("Rollout restart", true).ChannelWaitForConfirmedClick(confirm, func() {
+		err := MutateWorkload(ctx, ns, workload, "Rollout restart", func(reqContext context.Context, clientset kubernetes.Interface) error {
+			return RolloutRestart(reqContext, clientset, ns, workload, time.Now())
+		})
+		afterWorkloadMutation(ctx, ns, err)
+	})
+	if workload.Kind == WorkloadDaemonSet {
+		return
+	}
+	for _, replicas := range GetConfig().Workloads.ScaleReplicas {
+		replicas := int32(replicas)
+		operation := fmt.Sprintf("Scale to %d", replicas)
+		workloadElement.AddChild(operation, true).ChannelWaitForConfirmedClick(confirm, func() {
+			err := MutateWorkload(ctx, ns, workload, operation, func(reqContext context.Context, clientset kubernetes.Interface) error {
+				return ScaleWorkload(reqContext, clientset, ns, workload, replicas)
+			})
+			afterWorkloadMutation(ctx, ns, err)
+		})
+	}
+}
+
+func afterWorkloadMutation(ctx string, ns string, err error) {
+	if err != nil {
+		ShowLastError(err)
+		return
+	}
+	rootElement.UpdateWorkloadsData(ctx, ns)
+}
+
+// ChannelWaitForConfirmedClick requires a second click within a few seconds when confirm returns true
+func (e *Element) ChannelWaitForConfirmedClick(confirm func() bool, handler func()) {
+	var armedUntil time.Time
+	e.ChannelWaitForClick(func() {
+		if !confirm() || time.Now().Before(armedUntil) {
+			armedUntil = time.Time{}
+			e.MenuItem.SetTitle(e.Title)
+			handler()
+			return
+		}
+		armedUntil = time.Now().Add(confirmTimeout)
+		e.MenuItem.SetTitle(fmt.Sprintf("Click again to confirm: %s", e.Title))
+		time.AfterFunc(confirmTimeout, func() {
+			e.MenuItem.SetTitle(e.Title)
+		})
+	})
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func newWorkloadsClientset() *fake.Clientset {
+	meta := metav1.ObjectMeta{Namespace: "default", Name: "web"}
+	return fake.NewSimpleClientset(
+		&appsv1.Deployment{ObjectMeta: meta},
+		&appsv1.StatefulSet{ObjectMeta: meta},
+		&appsv1.DaemonSet{ObjectMeta: meta},
+	)
+}
+
+// lastPatch returns the only patch sent to the clientset
+func lastPatch(t *testing.T, clientset *fake.Clientset) k8stesting.PatchAction {
+	t.Helper()
+	var patches []k8stesting.PatchAction
+	for _, action := range clientset.Actions() {
+		if patch, ok := action.(k8stesting.PatchAction); ok {
+			patches = append(patches, patch)
+		}
+	}
+	if len(patches) != 1 {
+		t.Fatalf("%d patches sent, want 1", len(patches))
+	}
+	return patches[0]
+}
+
+func TestRolloutRestart(t *testing.T) {
+	now := time.Date(2022, 3, 4, 5, 6, 7, 0, time.UTC)
+	want := `{"spec":{"template":{"metadata":{"annotations":{"kubectl.kubernetes.io/restartedAt":"2022-03-04T05:06:07Z"}}}}}`
+	for kind, resource := range map[string]string{
+		WorkloadDeployment:  "deployments",
+		WorkloadStatefulSet: "statefulsets",
+		WorkloadDaemonSet:   "daemonsets",
+	} {
+		t.Run(kind, func(t *testing.T) {
+			clientset := newWorkloadsClientset()
+			if err := RolloutRestart(context.Background(), clientset, "default", Workload{Kind: kind, Name: "web"}, now); err != nil {
+				t.Fatal(err)
+			}
+			patch := lastPatch(t, clientset)
+			if patch.GetResource().Resource != resource || patch.GetName() != "web" || patch.GetNamespace() != "default" {
+				t.Errorf("patched %s %s/%s", patch.GetResource().Resource, patch.GetNamespace(), patch.GetName())
+			}
+			if patch.GetPatchType() != types.StrategicMergePatchType {
+				t.Errorf("patch type %s, want %s", patch.GetPatchType(), types.StrategicMergePatchType)
+			}
+			if patch.GetSubresource() != "" {
+				t.Errorf("subresource %q, want none", patch.GetSubresource())
+			}
+			if string(patch.GetPatch()) != want {
+				t.Errorf("patch %s, want %s", patch.GetPatch(), want)
+			}
+		})
+	}
+}
+
+func TestScaleWorkload(t *testing.T) {
+	for kind, resource := range map[string]string{
+		WorkloadDeployment:  "deployments",
+		WorkloadStatefulSet: "statefulsets",
+	} {
+		t.Run(kind, func(t *testing.T) {
+			clientset := newWorkloadsClientset()
+			if err := ScaleWorkload(context.Background(), clientset, "default", Workload{Kind: kind, Name: "web"}, 3); err != nil {
+				t.Fatal(err)
+			}
+			patch := lastPatch(t, clientset)
+			if patch.GetResource().Resource != resource || patch.GetName() != "web" {
+				t.Errorf("patched %s %s", patch.GetResource().Resource, patch.GetName())
+			}
+			if patch.GetPatchType() != types.MergePatchType {
+				t.Errorf("patch type %s, want %s", patch.GetPatchType(), types.MergePatchType)
+			}
+			if patch.GetSubresource() != "scale" {
+				t.Errorf("subresource %q, want scale", patch.GetSubresource())
+			}
+			if want := `{"spec":{"replicas":3}}`; string(patch.GetPatch()) != want {
+				t.Errorf("patch %s, want %s", patch.GetPatch(), want)
+			}
+		})
+	}
+}
+
+func TestScaleDaemonSetFails(t *testing.T) {
+	clientset := newWorkloadsClientset()
+	if err := ScaleWorkload(context.Background(), clientset, "default", Workload{Kind: WorkloadDaemonSet, Name: "web"}, 3); err == nil {
+		t.Error("expected an error scaling a daemon set")
+	}
+	if len(clientset.Actions()) != 0 {
+		t.Errorf("sent %v", clientset.Actions())
+	}
+}
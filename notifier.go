@@ -0,0 +1,89 @@
+package main
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Notification is a desktop notification, notifications sharing a Key are deduplicated
+type Notification struct {
+	Key     string
+	Title   string
+	Message string
+}
+
+// Notifier raises desktop notifications
+type Notifier interface {
+	Notify(notification Notification) error
+}
+
+var notifier Notifier = LogNotifier{}
+
+// LogNotifier only writes notifications to the log, used where no desktop notifications are available
+type LogNotifier struct{}
+
+func (LogNotifier) Notify(notification Notification) error {
+	trayLog.Infof("Notification: %s: %s", notification.Title, notification.Message)
+	return nil
+}
+
+// ThrottledNotifier drops notifications whose Key was notified within Window and allows at most Limit per minute,
+// the number of dropped notifications is appended to the next one
+type ThrottledNotifier struct {
+	Notifier Notifier
+	Window   time.Duration
+	Limit    int
+
+	mutex      sync.Mutex
+	notifiedAt map[string]time.Time
+	sent       []time.Time
+	suppressed int
+}
+
+func NewThrottledNotifier(notifier Notifier, window time.Duration, limit int) *ThrottledNotifier {
+	return &ThrottledNotifier{
+		Notifier:   notifier,
+		Window:     window,
+		Limit:      limit,
+		notifiedAt: map[string]time.Time{},
+	}
+}
+
+func (throttled *ThrottledNotifier) SetLimits(window time.Duration, limit int) {
+	throttled.mutex.Lock()
+	defer throttled.mutex.Unlock()
+	throttled.Window = window
+	throttled.Limit = limit
+}
+
+func (throttled *ThrottledNotifier) Notify(notification Notification) error {
+	throttled.mutex.Lock()
+	now := time.Now()
+	for key, notifiedAt := range throttled.notifiedAt {
+		if now.Sub(notifiedAt) >= throttled.Window {
+			delete(throttled.notifiedAt, key)
+		}
+	}
+	if _, ok := throttled.notifiedAt[notification.Key]; ok && notification.Key != "" {
+		throttled.mutex.Unlock()
+		return nil
+	}
+	for len(throttled.sent) > 0 && now.Sub(throttled.sent[0]) >= time.Minute {
+		throttled.sent = throttled.sent[1:]
+	}
+	if throttled.Limit > 0 && len(throttled.sent) >= throttled.Limit {
+		throttled.suppressed++
+		throttled.mutex.Unlock()
+		trayLog.Debugf("Notification rate limited: %s", notification.Title)
+		return nil
+	}
+	if throttled.suppressed > 0 {
+		notification.Message = fmt.Sprintf("%s\n(+%d more)", notification.Message, throttled.suppressed)
+		throttled.suppressed = 0
+	}
+	throttled.notifiedAt[notification.Key] = now
+	throttled.sent = append(throttled.sent, now)
+	throttled.mutex.Unlock()
+	return throttled.Notifier.Notify(notification)
+}
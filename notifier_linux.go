@@ -0,0 +1,42 @@
+package main
+
+import (
+	"github.com/godbus/dbus/v5"
+)
+
+const (
+	notificationsDestination = "org.freedesktop.Notifications"
+	notificationsPath        = "/org/freedesktop/Notifications"
+	notificationsNotify      = "org.freedesktop.Notifications.Notify"
+)
+
+// DBusNotifier raises notifications through the freedesktop notification service of the session bus
+type DBusNotifier struct {
+	conn *dbus.Conn
+}
+
+func NewDBusNotifier() (*DBusNotifier, error) {
+	conn, err := dbus.SessionBus()
+	if err != nil {
+		return nil, err
+	}
+	return &DBusNotifier{conn: conn}, nil
+}
+
+func (dbusNotifier *DBusNotifier) Notify(notification Notification) error {
+	object := dbusNotifier.conn.Object(notificationsDestination, notificationsPath)
+	// app_name, replaces_id, app_icon, summary, body, actions, hints, expire_timeout
+	call := object.Call(notificationsNotify, 0, "kube-tray", uint32(0), "", notification.Title, notification.Message,
+		[]string{}, map[string]dbus.Variant{}, int32(-1))
+	return call.Err
+}
+
+// NewDesktopNotifier returns the D-Bus notifier, or logs notifications when no session bus is available
+func NewDesktopNotifier() Notifier {
+	dbusNotifier, err := NewDBusNotifier()
+	if err != nil {
+		trayLog.Warningf("Desktop notifications unavailable: %s", err)
+		return LogNotifier{}
+	}
+	return dbusNotifier
+}
@@ -0,0 +1,9 @@
+//go:build !linux
+// +build !linux
+
+package main
+
+// NewDesktopNotifier logs notifications, desktop notifications are only implemented with D-Bus on Linux
+func NewDesktopNotifier() Notifier {
+	return LogNotifier{}
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+// RecordingNotifier keeps the notifications instead of showing them
+type RecordingNotifier struct {
+	mutex         sync.Mutex
+	notifications []Notification
+}
+
+func (recorder *RecordingNotifier) Notify(notification Notification) error {
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+	recorder.notifications = append(recorder.notifications, notification)
+	return nil
+}
+
+// Notifications returns the recorded notifications in order
+func (recorder *RecordingNotifier) Notifications() []Notification {
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+	return append([]Notification{}, recorder.notifications...)
+}
+
+func TestThrottledNotifierDedupe(t *testing.T) {
+	recorder := &RecordingNotifier{}
+	throttled := NewThrottledNotifier(recorder, time.Minute, 0)
+	throttled.Notify(Notification{Key: "a", Title: "first"})
+	throttled.Notify(Notification{Key: "a", Title: "repeated"})
+	throttled.Notify(Notification{Key: "b", Title: "other"})
+	throttled.Notify(Notification{Title: "without key"})
+	throttled.Notify(Notification{Title: "without key"})
+
+	// Move the first notification out of the window
+	throttled.notifiedAt["a"] = time.Now().Add(-time.Minute)
+	throttled.Notify(Notification{Key: "a", Title: "after window"})
+
+	assertNotified(t, recorder, "first", "other", "without key", "without key", "after window")
+}
+
+func TestThrottledNotifierRateLimit(t *testing.T) {
+	recorder := &RecordingNotifier{}
+	throttled := NewThrottledNotifier(recorder, time.Minute, 2)
+	for _, key := range []string{"a", "b", "c", "d"} {
+		throttled.Notify(Notification{Key: key, Title: key, Message: key})
+	}
+	assertNotified(t, recorder, "a", "b")
+
+	// A minute later the limit allows new notifications, telling how many were dropped
+	for i := range throttled.sent {
+		throttled.sent[i] = throttled.sent[i].Add(-time.Minute)
+	}
+	throttled.Notify(Notification{Key: "e", Title: "e", Message: "e"})
+	assertNotified(t, recorder, "a", "b", "e")
+	if message := recorder.Notifications()[2].Message; message != "e\n(+2 more)" {
+		t.Errorf("message %q, want the dropped count", message)
+	}
+}
+
+func assertNotified(t *testing.T, recorder *RecordingNotifier, titles ...string) {
+	t.Helper()
+	notifications := recorder.Notifications()
+	got := []string{}
+	for _, notification := range notifications {
+		got = append(got, notification.Title)
+	}
+	if len(got) != len(titles) {
+		t.Fatalf("notified %v, want %v", got, titles)
+	}
+	for i := range titles {
+		if got[i] != titles[i] {
+			t.Fatalf("notified %v, want %v", got, titles)
+		}
+	}
+}
@@ -0,0 +1,164 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/httpstream"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/remotecommand"
+	"k8s.io/client-go/transport/spdy"
+)
+
+// Arguments appended to the terminal command, rendered with the same templates as the shell command
+var (
+	execShellCommand  = []string{"kubectl", "--context", "{{.Context}}", "exec", "-it", "-n", "{{.Namespace}}", "{{.Pod}}", "-c", "{{.Container}}", "--", "{{.Shell}}"}
+	debugShellCommand = []string{"kubectl", "--context", "{{.Context}}", "debug", "-it", "-n", "{{.Namespace}}", "{{.Pod}}", "--target", "{{.Container}}", "--image"}
+)
+
+// ExecShell opens a terminal running an interactive shell in the container, using the first of `exec.shells` found
+func ExecShell(ctx string, ns string, pod string, container string) error {
+	config, ok := GetContextKubeconfig(ctx)
+	if !ok {
+		return fmt.Errorf("context %s not found", ctx)
+	}
+	restConfig, err := NewRestConfig(config)
+	if err != nil {
+		return err
+	}
+	clientset, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return err
+	}
+	shell, err := FindShell(restConfig, clientset, ns, pod, container, GetConfig().Exec.Shells)
+	if err != nil {
+		return err
+	}
+	description := fmt.Sprintf("Exec %s in %s | %s | pod/%s [%s]", shell, ctx, ns, pod, container)
+	return startPodTerminal(description, ctx, ns, pod, container, shell, execShellCommand)
+}
+
+// DebugShell opens a terminal attached to an ephemeral `exec.debug-image` container targeting the container
+func DebugShell(ctx string, ns string, pod string, container string) error {
+	image := GetConfig().Exec.DebugImage
+	config, ok := GetContextKubeconfig(ctx)
+	if !ok {
+		return fmt.Errorf("context %s not found", ctx)
+	}
+	// Ephemeral containers cannot be removed from the pod, keep track of them like other mutations
+	kubeLog.WithFields(log.Fields{
+		"context":   ctx,
+		"namespace": ns,
+		"object":    fmt.Sprintf("pod/%s", pod),
+		"user":      config.Contexts[ctx].AuthInfo,
+	}).Infof("Debug container %s with %s", container, image)
+	description := fmt.Sprintf("Debug %s | %s | pod/%s [%s]", ctx, ns, pod, container)
+	return startPodTerminal(description, ctx, ns, pod, container, "", append(append([]string{}, debugShellCommand...), image))
+}
+
+// startPodTerminal runs the pod command in `exec.terminal`, falling back to the shell command of the context when emptied
+func startPodTerminal(description string, ctx string, ns string, pod string, container string, shell string, podCommand []string) error {
+	settings := GetContextSettings(ctx)
+	terminal := GetConfig().Exec.Terminal
+	if len(terminal) == 0 {
+		terminal = GetShellCommand(settings)
+	}
+	data := NewTerminalData(ctx, ns)
+	data.Pod = pod
+	data.Container = container
+	data.Shell = shell
+	command := append(append([]string{}, terminal...), podCommand...)
+	return StartCommand(description, command, data, settings)
+}
+
+// FindShell returns the first shell which runs in the container
+func FindShell(restConfig *rest.Config, clientset kubernetes.Interface, ns string, pod string, container string, shells []string) (string, error) {
+	var lastErr error
+	for _, shell := range shells {
+		lastErr = probeShell(restConfig, clientset, ns, pod, container, shell)
+		if lastErr == nil {
+			return shell, nil
+		}
+		if apierrors.IsForbidden(lastErr) || apierrors.IsNotFound(lastErr) {
+			return "", lastErr
+		}
+		kubeLog.Debugf("Shell %s unavailable in %s/%s [%s]: %s", shell, ns, pod, container, lastErr)
+	}
+	if lastErr == nil {
+		return "", fmt.Errorf("no shell configured in exec.shells")
+	}
+	return "", fmt.Errorf("none of %s runs in %s [%s], try Debug shell: %w", strings.Join(shells, ", "), pod, container, lastErr)
+}
+
+func probeShell(restConfig *rest.Config, clientset kubernetes.Interface, ns string, pod string, container string, shell string) error {
+	url := clientset.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(ns).
+		Name(pod).
+		SubResource("exec").
+		VersionedParams(&v1.PodExecOptions{
+			Container: container,
+			Command:   []string{shell, "-c", "exit 0"},
+			Stdout:    true,
+			Stderr:    true,
+		}, scheme.ParameterCodec).
+		URL()
+	timeout := GetConfig().AutoRefresh.Timeout.Duration()
+	reqContext, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	transport, upgrader, err := spdy.RoundTripperFor(restConfig)
+	if err != nil {
+		return err
+	}
+	executor, err := remotecommand.NewSPDYExecutorForTransports(
+		contextRoundTripper{RoundTripper: transport, reqContext: reqContext},
+		contextUpgrader{Upgrader: upgrader, reqContext: reqContext},
+		http.MethodPost, url)
+	if err != nil {
+		return err
+	}
+	err = executor.Stream(remotecommand.StreamOptions{Stdout: io.Discard, Stderr: io.Discard})
+	if reqContext.Err() == context.DeadlineExceeded {
+		return fmt.Errorf("%s did not exit within %s", shell, timeout)
+	}
+	return err
+}
+
+// contextRoundTripper bounds dialing and upgrading the exec request by the context
+type contextRoundTripper struct {
+	http.RoundTripper
+	reqContext context.Context
+}
+
+func (roundTripper contextRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return roundTripper.RoundTripper.RoundTrip(req.WithContext(roundTripper.reqContext))
+}
+
+// contextUpgrader closes the upgraded connection once the context is done, Stream has no other way to be cancelled
+type contextUpgrader struct {
+	spdy.Upgrader
+	reqContext context.Context
+}
+
+func (upgrader contextUpgrader) NewConnection(resp *http.Response) (httpstream.Connection, error) {
+	conn, err := upgrader.Upgrader.NewConnection(resp)
+	if err != nil {
+		return nil, err
+	}
+	go func() {
+		select {
+		case <-upgrader.reqContext.Done():
+			conn.Close()
+		case <-conn.CloseChan():
+		}
+	}()
+	return conn, nil
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/httpstream"
+	"k8s.io/apimachinery/pkg/util/httpstream/spdy"
+	"k8s.io/client-go/rest"
+)
+
+// TestProbeShellTimeout runs the probe against a container which never exits, the connection must be closed once
+// the probe gave up instead of leaking with its stream
+func TestProbeShellTimeout(t *testing.T) {
+	setupTestConfig(t, func(config *Config) {
+		config.AutoRefresh.Timeout = 1
+	})
+	connCh := make(chan httpstream.Connection, 1)
+	server, clientset := newTestServerClientset(t, func(w http.ResponseWriter, r *http.Request) {
+		if _, err := httpstream.Handshake(r, w, []string{"v4.channel.k8s.io"}); err != nil {
+			return
+		}
+		conn := spdy.NewResponseUpgrader().UpgradeResponse(w, r, func(stream httpstream.Stream, replySent <-chan struct{}) error {
+			return nil
+		})
+		if conn != nil {
+			connCh <- conn
+		}
+	})
+
+	err := probeShell(&rest.Config{Host: server.URL}, clientset, "default", "web-1", "app", "sh")
+	if err == nil || !strings.Contains(err.Error(), "did not exit within 1s") {
+		t.Fatalf("probe returned %v", err)
+	}
+	select {
+	case conn := <-connCh:
+		select {
+		case <-conn.CloseChan():
+		case <-time.After(5 * time.Second):
+			t.Error("exec connection left open after the probe gave up")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("exec request not upgraded")
+	}
+}
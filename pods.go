@@ -0,0 +1,135 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	podsTitle     = "Pods"
+	loadPodsTitle = "Load pods"
+)
+
+// Pod is a pod listed in the Pods submenu of its namespace
+type Pod struct {
+	Name       string
+	Phase      string
+	Containers []string
+}
+
+// GetPods lists the pods of the namespace with their containers
+func GetPods(reqContext context.Context, clientset kubernetes.Interface, ns string) ([]Pod, error) {
+	pods, err := clientset.CoreV1().Pods(ns).List(reqContext, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	result := []Pod{}
+	for _, pod := range pods.Items {
+		containers := []string{}
+		for _, container := range pod.Spec.Containers {
+			containers = append(containers, container.Name)
+		}
+		result = append(result, Pod{Name: pod.Name, Phase: string(pod.Status.Phase), Containers: containers})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return result, nil
+}
+
+// AddPodsMenu adds the Pods submenu of a namespace, its pods are loaded when requested
+func (nsElement *Element) AddPodsMenu(ctx string, ns string) {
+	podsElement := nsElement.AddChild(podsTitle, true)
+	podsElement.AddChild(loadPodsTitle, true).ChannelWaitForClick(func() {
+		trayLog.Infof("Load pods for %s | %s", ctx, ns)
+		rootElement.UpdatePodsData(ctx, ns)
+	})
+}
+
+func (rootElement *Element) UpdatePodsData(ctx string, ns string) {
+	config, ok := GetContextKubeconfig(ctx)
+	if !ok {
+		return
+	}
+	clientset, err := NewClientset(config)
+	if err != nil {
+		ShowLastError(err)
+		return
+	}
+	reqContext, cancel := context.WithTimeout(context.Background(), GetConfig().AutoRefresh.Timeout.Duration())
+	defer cancel()
+	pods, err := GetPods(reqContext, clientset, ns)
+	if err != nil {
+		ShowLastError(fmt.Errorf("unable to list pods of %s | %s: %w", ctx, ns, err))
+		return
+	}
+
+	rootElement.mutex.Lock()
+	defer rootElement.mutex.Unlock()
+	if ctxElement, ok := rootElement.Children[ctx]; ok {
+		if nsElement, ok := ctxElement.Children[ns]; ok {
+			nsElement.SetPods(ctx, pods)
+		}
+	}
+}
+
+// SetPods replaces the entries of the Pods submenu
+func (nsElement *Element) SetPods(ctx string, pods []Pod) {
+	podsElement, ok := nsElement.Children[podsTitle]
+	if !ok {
+		return
+	}
+	podsElement.Children[loadPodsTitle].MenuItem.SetTitle("Reload pods")
+	podsElement.ElementTraversalMarkNonUpdated()
+	for _, pod := range pods {
+		podElement := podsElement.UpsertPod(ctx, nsElement.Title, pod)
+		podElement.MenuItem.SetTitle(fmt.Sprintf("%s (%s)", pod.Name, pod.Phase))
+	}
+	podsElement.ElementTraversalDisposeNonUpdated()
+}
+
+func (podsElement *Element) UpsertPod(ctx string, ns string, pod Pod) *Element {
+	if existingElement, ok := podsElement.Children[pod.Name]; ok {
+		existingElement.Updated = true
+		return existingElement
+	}
+	if disposedElement, ok := podsElement.Revive(pod.Name); ok {
+		return disposedElement
+	}
+	podElement := podsElement.AddChild(pod.Name, false)
+	podElement.AddPodActions(ctx, ns, pod)
+	return podElement
+}
+
+// AddPodActions adds the per container actions of a pod
+func (podElement *Element) AddPodActions(ctx string, ns string, pod Pod) {
+	tailElement := podElement.AddChild("Tail logs", true)
+	execElement := podElement.AddChild("Exec shell", true)
+	debugElement := podElement.AddChild("Debug shell", true)
+	confirm := func() bool {
+		return IsProtectedContext(ctx)
+	}
+	for _, container := range pod.Containers {
+		container := container
+		spec := LogTailSpec{Context: ctx, Namespace: ns, Pod: pod.Name, Container: container}
+		tailElement.AddChild(container, true).ChannelWaitForClick(func() {
+			if err := StartLogTail(spec); err != nil {
+				ShowLastError(fmt.Errorf("unable to tail logs of %s: %w", spec.ID(), err))
+			}
+		})
+		execElement.AddChild(container, true).ChannelWaitForClick(func() {
+			if err := ExecShell(ctx, ns, pod.Name, container); err != nil {
+				ShowLastError(fmt.Errorf("unable to exec into %s: %w", spec.ID(), err))
+			}
+		})
+		debugElement.AddChild(container, true).ChannelWaitForConfirmedClick(confirm, func() {
+			if err := DebugShell(ctx, ns, pod.Name, container); err != nil {
+				ShowLastError(fmt.Errorf("unable to debug %s: %w", spec.ID(), err))
+			}
+		})
+	}
+}
@@ -0,0 +1,581 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/httpstream"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/portforward"
+	"k8s.io/client-go/transport/spdy"
+)
+
+const (
+	portForwardTargetsTitle     = "Port forward"
+	loadPortForwardTargetsTitle = "Load targets"
+	portForwardReconnectDelay   = 3 * time.Second
+)
+
+// Labels that differ between the pods of the same workload
+var podInstanceLabels = []string{"pod-template-hash", "controller-revision-hash", "statefulset.kubernetes.io/pod-name"}
+
+// PortForwardSpec describes a port-forward, Target is service/<name> or pod/<name>
+type PortForwardSpec struct {
+	Name      string `mapstructure:"name"`
+	Context   string `mapstructure:"context"`
+	Namespace string `mapstructure:"namespace"`
+	Target    string `mapstructure:"target"`
+	Port      int    `mapstructure:"port"`
+	LocalPort int    `mapstructure:"local-port"`
+}
+
+// PortForward is a running port-forward, reconnecting until stopped
+type PortForward struct {
+	Spec   PortForwardSpec
+	Status string
+	stopCh chan struct{}
+	// Only used by the forwarding goroutine to follow replaced pods
+	podLabels map[string]string
+}
+
+var (
+	portForwardMutex   sync.Mutex
+	portForwards       = map[string]*PortForward{}
+	portForwardElement *Element
+)
+
+func (spec PortForwardSpec) ID() string {
+	return fmt.Sprintf("%s | %s | %s:%d", spec.Context, spec.Namespace, spec.Target, spec.Port)
+}
+
+func (forward *PortForward) String() string {
+	return fmt.Sprintf("%s -> localhost:%d (%s)", forward.Spec.ID(), forward.Spec.LocalPort, forward.Status)
+}
+
+// GetSavedPortForwards returns the port-forwards configured in `port-forwards`
+func GetSavedPortForwards() []PortForwardSpec {
+	return GetConfig().PortForwards
+}
+
+// StartPortForward starts forwarding unless the same target is already forwarded
+func StartPortForward(spec PortForwardSpec) {
+	portForwardMutex.Lock()
+	if _, ok := portForwards[spec.ID()]; ok {
+		portForwardMutex.Unlock()
+		return
+	}
+	if spec.LocalPort == 0 && spec.Port >= 1024 {
+		spec.LocalPort = spec.Port
+	}
+	forward := &PortForward{Spec: spec, Status: "connecting", stopCh: make(chan struct{})}
+	portForwards[spec.ID()] = forward
+	portForwardMutex.Unlock()
+
+	kubeLog.Infof("Start port-forward %s", spec.ID())
+	go forward.run()
+	UpdatePortForwardMenu()
+}
+
+func StopPortForward(id string) {
+	portForwardMutex.Lock()
+	forward, ok := portForwards[id]
+	if ok {
+		delete(portForwards, id)
+		close(forward.stopCh)
+	}
+	portForwardMutex.Unlock()
+	if ok {
+		kubeLog.Infof("Stop port-forward %s", id)
+		UpdatePortForwardMenu()
+	}
+}
+
+func StopAllPortForwards() {
+	for _, forward := range GetPortForwards() {
+		StopPortForward(forward.Spec.ID())
+	}
+}
+
+// GetPortForwards returns a snapshot of the running port-forwards sorted by ID
+func GetPortForwards() []PortForward {
+	portForwardMutex.Lock()
+	defer portForwardMutex.Unlock()
+	forwards := []PortForward{}
+	for _, forward := range portForwards {
+		forwards = append(forwards, PortForward{Spec: forward.Spec, Status: forward.Status})
+	}
+	sort.Slice(forwards, func(i, j int) bool {
+		return forwards[i].Spec.ID() < forwards[j].Spec.ID()
+	})
+	return forwards
+}
+
+// spec copies the spec under the lock, the forwarding goroutine updates its local port
+func (forward *PortForward) spec() PortForwardSpec {
+	portForwardMutex.Lock()
+	defer portForwardMutex.Unlock()
+	return forward.Spec
+}
+
+func (forward *PortForward) setStatus(status string) {
+	portForwardMutex.Lock()
+	forward.Status = status
+	portForwardMutex.Unlock()
+	UpdatePortForwardMenu()
+}
+
+// run forwards to the current pod of the target and reconnects when the pod goes away
+func (forward *PortForward) run() {
+	id := forward.spec().ID()
+	for {
+		err := forward.forwardOnce()
+		select {
+		case <-forward.stopCh:
+			return
+		default:
+		}
+		if err != nil {
+			kubeLog.Warningf("Port-forward %s: %s", id, err)
+			forward.setStatus(fmt.Sprintf("retrying: %s", err))
+		} else {
+			kubeLog.Infof("Port-forward %s lost connection, reconnecting", id)
+			forward.setStatus("reconnecting")
+		}
+		select {
+		case <-forward.stopCh:
+			return
+		case <-time.After(portForwardReconnectDelay):
+		}
+	}
+}
+
+func (forward *PortForward) forwardOnce() error {
+	spec := forward.spec()
+	config, ok := GetContextKubeconfig(spec.Context)
+	if !ok {
+		return fmt.Errorf("context %s not found", spec.Context)
+	}
+	restConfig, err := NewRestConfig(config)
+	if err != nil {
+		return err
+	}
+	clientset, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return err
+	}
+	reqContext, cancel := context.WithTimeout(context.Background(), GetConfig().AutoRefresh.Timeout.Duration())
+	pod, targetPort, err := forward.resolveTarget(reqContext, clientset)
+	cancel()
+	if err != nil {
+		return err
+	}
+
+	dialer, err := newPortForwardDialer(restConfig, clientset, pod)
+	if err != nil {
+		return err
+	}
+	// ForwardPorts may not return when the pod goes away, the connection is closed to reconnect to its successor
+	watchContext, cancelWatch := context.WithCancel(context.Background())
+	defer cancelWatch()
+	connectionStopCh := make(chan struct{})
+	goneCh := watchPodGone(watchContext, clientset, pod)
+	go func() {
+		defer close(connectionStopCh)
+		select {
+		case <-forward.stopCh:
+		case <-goneCh:
+			kubeLog.Infof("Port-forward %s: pod/%s is gone", spec.ID(), pod.Name)
+		case <-watchContext.Done():
+		}
+	}()
+	readyCh := make(chan struct{})
+	ports := []string{fmt.Sprintf("%d:%d", spec.LocalPort, targetPort)}
+	forwarder, err := portforward.New(dialer, ports, connectionStopCh, readyCh, io.Discard, io.Discard)
+	if err != nil {
+		return err
+	}
+	go func() {
+		select {
+		case <-readyCh:
+		case <-connectionStopCh:
+			return
+		}
+		// Keep the local port of the first connection across reconnects
+		if forwardedPorts, err := forwarder.GetPorts(); err == nil && len(forwardedPorts) > 0 {
+			portForwardMutex.Lock()
+			forward.Spec.LocalPort = int(forwardedPorts[0].Local)
+			portForwardMutex.Unlock()
+		}
+		forward.setStatus(fmt.Sprintf("pod/%s", pod.Name))
+	}()
+	return forwarder.ForwardPorts()
+}
+
+func newPortForwardDialer(restConfig *rest.Config, clientset kubernetes.Interface, pod *v1.Pod) (httpstream.Dialer, error) {
+	transport, upgrader, err := spdy.RoundTripperFor(restConfig)
+	if err != nil {
+		return nil, err
+	}
+	url := clientset.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(pod.Namespace).
+		Name(pod.Name).
+		SubResource("portforward").
+		URL()
+	return spdy.NewDialer(upgrader, &http.Client{Transport: transport}, http.MethodPost, url), nil
+}
+
+// resolveTarget finds a running pod behind the target and the container port to forward to
+func (forward *PortForward) resolveTarget(reqContext context.Context, clientset kubernetes.Interface) (*v1.Pod, int, error) {
+	spec := forward.spec()
+	target := strings.SplitN(spec.Target, "/", 2)
+	if len(target) != 2 {
+		return nil, 0, fmt.Errorf("invalid target %s, expected service/<name> or pod/<name>", spec.Target)
+	}
+	kind, name := target[0], target[1]
+	switch kind {
+	case "service", "svc":
+		service, err := clientset.CoreV1().Services(spec.Namespace).Get(reqContext, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, 0, err
+		}
+		pod, err := findRunningPod(reqContext, clientset, spec.Namespace, service.Spec.Selector)
+		if err != nil {
+			return nil, 0, err
+		}
+		for _, servicePort := range service.Spec.Ports {
+			if int(servicePort.Port) != spec.Port {
+				continue
+			}
+			if servicePort.TargetPort.IntValue() > 0 {
+				return pod, servicePort.TargetPort.IntValue(), nil
+			}
+			if containerPort, ok := findContainerPort(pod, servicePort.TargetPort.String()); ok {
+				return pod, containerPort, nil
+			}
+		}
+		return pod, spec.Port, nil
+	case "pod", "po":
+		pod, err := clientset.CoreV1().Pods(spec.Namespace).Get(reqContext, name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) && forward.podLabels != nil {
+			// The pod was replaced, follow its workload
+			pod, err = findRunningPod(reqContext, clientset, spec.Namespace, forward.podLabels)
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		if forward.podLabels == nil {
+			forward.podLabels = workloadLabels(pod.Labels)
+		}
+		return pod, spec.Port, nil
+	default:
+		return nil, 0, fmt.Errorf("unsupported target kind %s", kind)
+	}
+}
+
+func findRunningPod(reqContext context.Context, clientset kubernetes.Interface, ns string, selector map[string]string) (*v1.Pod, error) {
+	if len(selector) == 0 {
+		return nil, fmt.Errorf("no selector to find pods in %s", ns)
+	}
+	pods, err := clientset.CoreV1().Pods(ns).List(reqContext, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(selector).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.Status.Phase == v1.PodRunning && pod.DeletionTimestamp == nil {
+			return pod, nil
+		}
+	}
+	return nil, fmt.Errorf("no running pod matches %s", labels.SelectorFromSet(selector))
+}
+
+// watchPodGone closes the returned channel once the pod is deleted, replaced by a pod of the same name or has
+// terminated, the watch is renewed until reqContext is done
+func watchPodGone(reqContext context.Context, clientset kubernetes.Interface, pod *v1.Pod) <-chan struct{} {
+	goneCh := make(chan struct{})
+	go func() {
+		resourceVersion := pod.ResourceVersion
+		for {
+			gone, err := watchPodGoneOnce(reqContext, clientset, pod, &resourceVersion)
+			if gone {
+				close(goneCh)
+				return
+			}
+			if reqContext.Err() != nil {
+				return
+			}
+			if err == nil {
+				continue
+			}
+			kubeLog.Warningf("Unable to watch pod/%s of [%s]: %s", pod.Name, pod.Namespace, err)
+			resourceVersion = ""
+			select {
+			case <-reqContext.Done():
+				return
+			case <-time.After(portForwardReconnectDelay):
+			}
+		}
+	}()
+	return goneCh
+}
+
+func watchPodGoneOnce(reqContext context.Context, clientset kubernetes.Interface, pod *v1.Pod, resourceVersion *string) (bool, error) {
+	pods := clientset.CoreV1().Pods(pod.Namespace)
+	if *resourceVersion == "" {
+		current, err := pods.Get(reqContext, pod.Name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return true, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		if isPodGone(pod, current) {
+			return true, nil
+		}
+		*resourceVersion = current.ResourceVersion
+	}
+	watcher, err := pods.Watch(reqContext, metav1.ListOptions{
+		FieldSelector:   fields.OneTermEqualSelector("metadata.name", pod.Name).String(),
+		ResourceVersion: *resourceVersion,
+	})
+	if err != nil {
+		return false, err
+	}
+	defer watcher.Stop()
+	for result := range watcher.ResultChan() {
+		if result.Type == watch.Error {
+			return false, apierrors.FromObject(result.Object)
+		}
+		current, ok := result.Object.(*v1.Pod)
+		if !ok || current.Name != pod.Name {
+			continue
+		}
+		if result.Type == watch.Deleted || isPodGone(pod, current) {
+			return true, nil
+		}
+		*resourceVersion = current.ResourceVersion
+	}
+	return false, nil
+}
+
+func isPodGone(pod *v1.Pod, current *v1.Pod) bool {
+	return current.UID != pod.UID || current.DeletionTimestamp != nil ||
+		current.Status.Phase == v1.PodSucceeded || current.Status.Phase == v1.PodFailed
+}
+
+func findContainerPort(pod *v1.Pod, name string) (int, bool) {
+	for _, container := range pod.Spec.Containers {
+		for _, port := range container.Ports {
+			if port.Name == name {
+				return int(port.ContainerPort), true
+			}
+		}
+	}
+	return 0, false
+}
+
+func workloadLabels(podLabels map[string]string) map[string]string {
+	selector := map[string]string{}
+	for key, value := range podLabels {
+		selector[key] = value
+	}
+	for _, key := range podInstanceLabels {
+		delete(selector, key)
+	}
+	if len(selector) == 0 {
+		return nil
+	}
+	return selector
+}
+
+// GetPortForwardTargets lists the service ports and declared pod ports of the namespace
+func GetPortForwardTargets(reqContext context.Context, clientset kubernetes.Interface, ctx string, ns string) ([]PortForwardSpec, error) {
+	specs := []PortForwardSpec{}
+	services, err := clientset.CoreV1().Services(ns).List(reqContext, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, service := range services.Items {
+		for _, port := range service.Spec.Ports {
+			if port.Protocol != "" && port.Protocol != v1.ProtocolTCP {
+				continue
+			}
+			specs = append(specs, PortForwardSpec{Context: ctx, Namespace: ns, Target: "service/" + service.Name, Port: int(port.Port)})
+		}
+	}
+	pods, err := clientset.CoreV1().Pods(ns).List(reqContext, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, pod := range pods.Items {
+		if pod.Status.Phase != v1.PodRunning {
+			continue
+		}
+		for _, container := range pod.Spec.Containers {
+			for _, port := range container.Ports {
+				if port.Protocol != "" && port.Protocol != v1.ProtocolTCP {
+					continue
+				}
+				specs = append(specs, PortForwardSpec{Context: ctx, Namespace: ns, Target: "pod/" + pod.Name, Port: int(port.ContainerPort)})
+			}
+		}
+	}
+	return specs, nil
+}
+
+// AddPortForwardMenu adds the Port forward submenu of a namespace, its targets are loaded when requested
+func (nsElement *Element) AddPortForwardMenu(ctx string, ns string) {
+	targetsElement := nsElement.AddChild(portForwardTargetsTitle, true)
+	targetsElement.AddChild(loadPortForwardTargetsTitle, true).ChannelWaitForClick(func() {
+		trayLog.Infof("Load port-forward targets for %s | %s", ctx, ns)
+		rootElement.UpdatePortForwardTargets(ctx, ns)
+	})
+}
+
+func (rootElement *Element) UpdatePortForwardTargets(ctx string, ns string) {
+	config, ok := GetContextKubeconfig(ctx)
+	if !ok {
+		return
+	}
+	clientset, err := NewClientset(config)
+	if err != nil {
+		ShowLastError(err)
+		return
+	}
+	reqContext, cancel := context.WithTimeout(context.Background(), GetConfig().AutoRefresh.Timeout.Duration())
+	defer cancel()
+	specs, err := GetPortForwardTargets(reqContext, clientset, ctx, ns)
+	if err != nil {
+		ShowLastError(fmt.Errorf("unable to list port-forward targets of %s | %s: %w", ctx, ns, err))
+		return
+	}
+
+	rootElement.mutex.Lock()
+	defer rootElement.mutex.Unlock()
+	ctxElement, ok := rootElement.Children[ctx]
+	if !ok {
+		return
+	}
+	nsElement, ok := ctxElement.Children[ns]
+	if !ok {
+		return
+	}
+	targetsElement := nsElement.Children[portForwardTargetsTitle]
+	targetsElement.Children[loadPortForwardTargetsTitle].MenuItem.SetTitle("Reload targets")
+	targetsElement.ElementTraversalMarkNonUpdated()
+	for _, spec := range specs {
+		title := fmt.Sprintf("%s:%d", spec.Target, spec.Port)
+		if _, ok := targetsElement.Children[title]; ok {
+			targetsElement.Children[title].Updated = true
+			continue
+		}
+		if _, ok := targetsElement.Revive(title); ok {
+			continue
+		}
+		spec := spec
+		targetsElement.AddChild(title, false).ChannelWaitForClick(func() {
+			StartPortForward(spec)
+		})
+	}
+	targetsElement.ElementTraversalDisposeNonUpdated()
+}
+
+// NewPortForwardMenu creates the top level Port Forwards menu with the saved port-forwards
+func NewPortForwardMenu(menu Menu) {
+	portForwardElement = &Element{
+		Menu:     menu,
+		MenuItem: menu.AddMenuItem("Port Forwards", "Active port-forwards"),
+		Children: map[string]*Element{},
+		Disposed: map[string]*Element{},
+		Updated:  true,
+		Locked:   true,
+	}
+	portForwardElement.AddChild("Stop all", true).ChannelWaitForClick(StopAllPortForwards)
+	syncSavedPortForwards()
+	separator := portForwardElement.MenuItem.AddSubMenuItem("", "")
+	separator.Disable()
+	UpdatePortForwardMenu()
+}
+
+// UpdateSavedPortForwards syncs the Start entries with the saved port-forwards of the config
+func UpdateSavedPortForwards() {
+	if portForwardElement == nil {
+		return
+	}
+	portForwardElement.mutex.Lock()
+	defer portForwardElement.mutex.Unlock()
+	syncSavedPortForwards()
+}
+
+func syncSavedPortForwards() {
+	configured := map[string]bool{}
+	for _, spec := range GetSavedPortForwards() {
+		spec := spec
+		title := spec.Name
+		if title == "" {
+			title = spec.ID()
+		}
+		title = fmt.Sprintf("Start %s", title)
+		configured[title] = true
+		startElement, ok := portForwardElement.Children[title]
+		if !ok {
+			if startElement, ok = portForwardElement.Revive(title); !ok {
+				startElement = portForwardElement.AddChild(title, true)
+			}
+		}
+		// The spec of a saved port-forward may have changed under the same name
+		startElement.stopClickListener()
+		startElement.ChannelWaitForClick(func() {
+			StartPortForward(spec)
+		})
+	}
+	for title, childElement := range portForwardElement.Children {
+		if childElement.Locked && strings.HasPrefix(title, "Start ") && !configured[title] {
+			childElement.Updated = false
+			portForwardElement.DisposeChildNonUpdated(title)
+		}
+	}
+}
+
+// UpdatePortForwardMenu lists the running port-forwards, each with a Stop action
+func UpdatePortForwardMenu() {
+	if portForwardElement == nil {
+		return
+	}
+	portForwardElement.mutex.Lock()
+	defer portForwardElement.mutex.Unlock()
+
+	forwards := GetPortForwards()
+	portForwardElement.MenuItem.SetTitle(fmt.Sprintf("Port Forwards (%d)", len(forwards)))
+	portForwardElement.ElementTraversalMarkNonUpdated()
+	for _, forward := range forwards {
+		id := forward.Spec.ID()
+		forwardElement, ok := portForwardElement.Children[id]
+		if ok {
+			forwardElement.Updated = true
+		} else if forwardElement, ok = portForwardElement.Revive(id); !ok {
+			forwardElement = portForwardElement.AddChild(id, false)
+			forwardElement.AddChild("Stop", true).ChannelWaitForClick(func() {
+				StopPortForward(id)
+			})
+		}
+		forwardElement.MenuItem.SetTitle(forward.String())
+	}
+	portForwardElement.ElementTraversalDisposeNonUpdated()
+}
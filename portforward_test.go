@@ -0,0 +1,137 @@
+package main
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func newRunningPod(name string, uid types.UID) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name, UID: uid, Labels: map[string]string{"app": "web"}},
+		Status:     v1.PodStatus{Phase: v1.PodRunning},
+	}
+}
+
+func assertPodGone(t *testing.T, goneCh <-chan struct{}, gone bool) {
+	t.Helper()
+	timeout := 5 * time.Second
+	if !gone {
+		timeout = 50 * time.Millisecond
+	}
+	select {
+	case <-goneCh:
+		if !gone {
+			t.Fatal("pod reported gone")
+		}
+	case <-time.After(timeout):
+		if gone {
+			t.Fatal("pod not reported gone")
+		}
+	}
+}
+
+// waitPodWatch waits for the watch to be established, the fake clientset misses events sent before
+func waitPodWatch(t *testing.T, clientset *fake.Clientset) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		for _, action := range clientset.Actions() {
+			if action.GetVerb() == "watch" && action.GetResource().Resource == "pods" {
+				return
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatal("pod not watched")
+}
+
+func TestWatchPodGoneDeleted(t *testing.T) {
+	pod := newRunningPod("web-1", "a")
+	clientset := fake.NewSimpleClientset(pod, newRunningPod("web-2", "b"))
+	reqContext, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	goneCh := watchPodGone(reqContext, clientset, pod)
+	waitPodWatch(t, clientset)
+	if err := clientset.CoreV1().Pods("default").Delete(reqContext, "web-2", metav1.DeleteOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	assertPodGone(t, goneCh, false)
+	if err := clientset.CoreV1().Pods("default").Delete(reqContext, "web-1", metav1.DeleteOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	assertPodGone(t, goneCh, true)
+}
+
+func TestWatchPodGoneTerminating(t *testing.T) {
+	pod := newRunningPod("web-1", "a")
+	clientset := fake.NewSimpleClientset(pod)
+	reqContext, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	goneCh := watchPodGone(reqContext, clientset, pod)
+	waitPodWatch(t, clientset)
+	terminating := pod.DeepCopy()
+	now := metav1.Now()
+	terminating.DeletionTimestamp = &now
+	if _, err := clientset.CoreV1().Pods("default").Update(reqContext, terminating, metav1.UpdateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	assertPodGone(t, goneCh, true)
+}
+
+func TestWatchPodGoneBeforeWatch(t *testing.T) {
+	reqContext, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Deleted
+	assertPodGone(t, watchPodGone(reqContext, fake.NewSimpleClientset(), newRunningPod("web-1", "a")), true)
+	// Replaced by a pod of the same name, like the pods of a stateful set
+	clientset := fake.NewSimpleClientset(newRunningPod("web-1", "b"))
+	assertPodGone(t, watchPodGone(reqContext, clientset, newRunningPod("web-1", "a")), true)
+}
+
+func TestWatchPodGoneStopsWithContext(t *testing.T) {
+	pod := newRunningPod("web-1", "a")
+	clientset := fake.NewSimpleClientset(pod)
+	reqContext, cancel := context.WithCancel(context.Background())
+	goneCh := watchPodGone(reqContext, clientset, pod)
+	waitPodWatch(t, clientset)
+	cancel()
+	assertPodGone(t, goneCh, false)
+}
+
+// TestResolveTargetWhileReady resolves the target while the ready goroutine records the local port, run with -race
+func TestResolveTargetWhileReady(t *testing.T) {
+	clientset := fake.NewSimpleClientset(newRunningPod("web-1", "a"))
+	forward := &PortForward{Spec: PortForwardSpec{Context: "kind-kind", Namespace: "default", Target: "pod/web-1", Port: 8080}}
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			portForwardMutex.Lock()
+			forward.Spec.LocalPort = 30000 + i
+			portForwardMutex.Unlock()
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			pod, port, err := forward.resolveTarget(context.Background(), clientset)
+			if err != nil || pod.Name != "web-1" || port != 8080 {
+				t.Errorf("resolved %v:%d, %v", pod, port, err)
+				return
+			}
+		}
+	}()
+	wg.Wait()
+}
@@ -0,0 +1,126 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const problemsTitle = "Problems"
+
+// PodProblem is a pod reported in the Problems submenu of its namespace
+type PodProblem struct {
+	Pod    string `json:"pod"`
+	Reason string `json:"reason"`
+}
+
+var (
+	problemsMutex   sync.Mutex
+	contextProblems = map[string]map[string][]PodProblem{}
+)
+
+// GetContextProblems returns the failing pods of the last refresh of the context by namespace
+func GetContextProblems(ctx string) map[string][]PodProblem {
+	problemsMutex.Lock()
+	defer problemsMutex.Unlock()
+	return contextProblems[ctx]
+}
+
+func setContextProblems(ctx string, problems map[string][]PodProblem) {
+	problemsMutex.Lock()
+	defer problemsMutex.Unlock()
+	contextProblems[ctx] = problems
+}
+
+var problemWaitingReasons = map[string]bool{
+	"CrashLoopBackOff":           true,
+	"ImagePullBackOff":           true,
+	"ErrImagePull":               true,
+	"CreateContainerConfigError": true,
+}
+
+// GetPodProblems lists the pods of the whole cluster once and groups the failing ones by namespace
+func GetPodProblems(reqContext context.Context, clientset kubernetes.Interface, pendingThreshold time.Duration) (map[string][]PodProblem, error) {
+	pods, err := clientset.CoreV1().Pods(metav1.NamespaceAll).List(reqContext, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	problems := map[string][]PodProblem{}
+	for _, pod := range pods.Items {
+		if reason, ok := DetectPodProblem(pod, now, pendingThreshold); ok {
+			problems[pod.Namespace] = append(problems[pod.Namespace], PodProblem{Pod: pod.Name, Reason: reason})
+		}
+	}
+	for _, nsProblems := range problems {
+		sort.Slice(nsProblems, func(i, j int) bool {
+			return nsProblems[i].Pod < nsProblems[j].Pod
+		})
+	}
+	return problems, nil
+}
+
+// DetectPodProblem returns why the pod is failing, pending and not ready pods only count after the threshold
+func DetectPodProblem(pod v1.Pod, now time.Time, pendingThreshold time.Duration) (string, bool) {
+	if pod.Status.Phase == v1.PodSucceeded {
+		return "", false
+	}
+	statuses := append(append([]v1.ContainerStatus{}, pod.Status.InitContainerStatuses...), pod.Status.ContainerStatuses...)
+	for _, status := range statuses {
+		if status.State.Waiting != nil && problemWaitingReasons[status.State.Waiting.Reason] {
+			return status.State.Waiting.Reason, true
+		}
+		if status.State.Terminated != nil && status.State.Terminated.Reason == "Error" {
+			return "Error", true
+		}
+	}
+
+	overdue := now.Sub(pod.CreationTimestamp.Time) > pendingThreshold
+	if pod.Status.Phase == v1.PodPending {
+		return string(v1.PodPending), overdue
+	}
+	if pod.Status.Phase != v1.PodRunning || !overdue {
+		return "", false
+	}
+	ready := 0
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.Ready {
+			ready++
+		}
+	}
+	if ready < len(pod.Status.ContainerStatuses) {
+		return fmt.Sprintf("NotReady %d/%d", ready, len(pod.Status.ContainerStatuses)), true
+	}
+	return "", false
+}
+
+// SetProblems shows the number of failing pods in the namespace title and lists them in a Problems submenu, entries
+// are reused in place as pods come and go
+func (nsElement *Element) SetProblems(problems []PodProblem) {
+	if len(problems) == 0 {
+		nsElement.MenuItem.SetTitle(nsElement.Title)
+		return
+	}
+	nsElement.MenuItem.SetTitle(fmt.Sprintf("%s (%d)", nsElement.Title, len(problems)))
+	problemsElement := nsElement.UpsertChild(problemsTitle, false)
+	for i, problem := range problems {
+		problemElement := problemsElement.UpsertChild(strconv.Itoa(i+1), true)
+		problemElement.MenuItem.SetTitle(fmt.Sprintf("%s: %s", problem.Pod, problem.Reason))
+		problemElement.MenuItem.Disable()
+		problemElement.MenuItem.Show()
+	}
+	for i := len(problems) + 1; ; i++ {
+		problemElement, ok := problemsElement.Children[strconv.Itoa(i)]
+		if !ok {
+			break
+		}
+		problemElement.MenuItem.Hide()
+	}
+}
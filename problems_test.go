@@ -0,0 +1,94 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+func newCrashingPod(ns string, name string) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: name},
+		Status: v1.PodStatus{
+			Phase: v1.PodRunning,
+			ContainerStatuses: []v1.ContainerStatus{{
+				Name:  "app",
+				State: v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}},
+			}},
+		},
+	}
+}
+
+func TestGetPodProblems(t *testing.T) {
+	clientset := newFakeClientset("default", "team-a")
+	for _, pod := range []*v1.Pod{
+		newCrashingPod("team-a", "b"),
+		newCrashingPod("team-a", "a"),
+		{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "ok"}, Status: v1.PodStatus{Phase: v1.PodSucceeded}},
+	} {
+		if _, err := clientset.CoreV1().Pods(pod.Namespace).Create(context.Background(), pod, metav1.CreateOptions{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	problems, err := GetPodProblems(context.Background(), clientset, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(problems["default"]) != 0 {
+		t.Errorf("default has problems %v", problems["default"])
+	}
+	want := []PodProblem{{Pod: "a", Reason: "CrashLoopBackOff"}, {Pod: "b", Reason: "CrashLoopBackOff"}}
+	if len(problems["team-a"]) != len(want) || problems["team-a"][0] != want[0] || problems["team-a"][1] != want[1] {
+		t.Errorf("team-a problems %v, want %v", problems["team-a"], want)
+	}
+}
+
+// TestProblemsReuseEntries replaces the failing pods between refreshes, the entries of gone pods are reused
+func TestProblemsReuseEntries(t *testing.T) {
+	clientset := newFakeClientset("default")
+	setupTestClusters(t, map[string]kubernetes.Interface{"kind-kind": clientset})
+	root, menu := newTestRoot(t)
+	pods := clientset.CoreV1().Pods("default")
+	for _, name := range []string{"web-1", "web-2"} {
+		if _, err := pods.Create(context.Background(), newCrashingPod("default", name), metav1.CreateOptions{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	root.UpdateData()
+
+	problemsItem := menu.Find("kind-kind", "default (2)", problemsTitle)
+	assertVisible(t, problemsItem, true, "default (2)", problemsTitle)
+	assertVisible(t, problemsItem.Find("web-1: CrashLoopBackOff"), true, "web-1")
+	assertVisible(t, problemsItem.Find("web-2: CrashLoopBackOff"), true, "web-2")
+
+	for i, name := range []string{"web-1", "web-2"} {
+		if err := pods.Delete(context.Background(), name, metav1.DeleteOptions{}); err != nil {
+			t.Fatal(err)
+		}
+		replacement := newCrashingPod("default", name+"-replaced")
+		if i == 0 {
+			if _, err := pods.Create(context.Background(), replacement, metav1.CreateOptions{}); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	root.UpdateData()
+
+	problemsItem = menu.Find("kind-kind", "default (1)", problemsTitle)
+	assertVisible(t, problemsItem, true, "default (1)", problemsTitle)
+	if got := len(problemsItem.Children()); got != 2 {
+		t.Errorf("problems have %d entries, want the 2 created first", got)
+	}
+	assertVisible(t, problemsItem.Find("web-1-replaced: CrashLoopBackOff"), true, "web-1-replaced")
+	assertVisible(t, problemsItem.Children()[1], false, "second entry")
+
+	if err := pods.Delete(context.Background(), "web-1-replaced", metav1.DeleteOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	root.UpdateData()
+	assertVisible(t, menu.Find("kind-kind", "default", problemsTitle), false, "default", problemsTitle)
+}
@@ -0,0 +1,129 @@
+package main
+
+import (
+	"context"
+	"sync"
+)
+
+var (
+	refreshMutex  sync.Mutex
+	refreshCancel context.CancelFunc
+)
+
+func (rootElement *Element) UpdateData() {
+	kubeLog.Info("Updating Data for all contexts")
+	// Mark all for pending deletion
+	rootElement.mutex.Lock()
+	rootElement.ElementTraversalMarkNonUpdated()
+	rootElement.mutex.Unlock()
+	// Update contexts
+	if !rootElement.RefreshContexts(GetKubeconfigContexts(), true) {
+		kubeLog.Info("Superseded by a newer refresh")
+		return
+	}
+	// Delete missing elements after updates
+	rootElement.mutex.Lock()
+	rootElement.upsertInvalidContexts()
+	rootElement.ElementTraversalDisposeNonUpdated()
+	rootElement.mutex.Unlock()
+}
+
+func (rootElement *Element) UpdateContextData(ctx string) {
+	rootElement.UpdateContextsData([]string{ctx})
+}
+
+// UpdateContextsData refreshes the given contexts without interrupting a running refresh of all contexts
+func (rootElement *Element) UpdateContextsData(ctxs []string) {
+	rootElement.mutex.Lock()
+	for _, ctx := range ctxs {
+		if ctxElement, ok := rootElement.Children[ctx]; ok {
+			ctxElement.ElementTraversalMarkNonUpdated()
+		}
+	}
+	rootElement.mutex.Unlock()
+	rootElement.RefreshContexts(ctxs, false)
+	rootElement.mutex.Lock()
+	for _, ctx := range ctxs {
+		rootElement.DisposeChildNonUpdated(ctx)
+	}
+	rootElement.mutex.Unlock()
+}
+
+// RefreshContexts fetches the contexts in parallel and applies each result as soon as it arrives,
+// changes to the tree and the written kubeconfigs are serialized by the root lock, returns false if the refresh was cancelled by a newer one
+func (rootElement *Element) RefreshContexts(ctxs []string, supersede bool) bool {
+	refreshContext, cancel := newRefreshContext(supersede)
+	defer cancel()
+	startRefreshStatus()
+	defer finishRefreshStatus()
+
+	FetchContexts(refreshContext, ctxs, func(data ContextData) {
+		// Checked under the lock, a newer refresh cancels this one before applying its own results
+		rootElement.mutex.Lock()
+		if refreshContext.Err() == context.Canceled {
+			rootElement.mutex.Unlock()
+			return
+		}
+		rootElement.ApplyContextData(data)
+		rootElement.mutex.Unlock()
+		UpdateTrayStatus()
+	})
+	return refreshContext.Err() != context.Canceled
+}
+
+// FetchContexts fetches the contexts with `auto-refresh.concurrency` workers, each bounded by `auto-refresh.timeout`,
+// only fetching runs concurrently, results are passed to apply one at a time
+func FetchContexts(refreshContext context.Context, ctxs []string, apply func(ContextData)) {
+	concurrency := GetConfig().AutoRefresh.Concurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	timeout := GetConfig().AutoRefresh.Timeout.Duration()
+
+	jobs := make(chan string)
+	results := make(chan ContextData)
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for ctx := range jobs {
+				ctxContext, ctxCancel := context.WithTimeout(refreshContext, timeout)
+				results <- FetchContextData(ctxContext, ctx)
+				ctxCancel()
+			}
+		}()
+	}
+	// Contexts left after the deadline fail fast and keep their last known state
+	go func() {
+		defer close(jobs)
+		for _, ctx := range ctxs {
+			jobs <- ctx
+		}
+	}()
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	for data := range results {
+		apply(data)
+	}
+}
+
+// newRefreshContext bounds a refresh by the overall deadline, superseding refreshes cancel the previous one
+func newRefreshContext(supersede bool) (context.Context, context.CancelFunc) {
+	deadline := GetConfig().AutoRefresh.Deadline.Duration()
+	refreshContext, cancel := context.WithTimeout(context.Background(), deadline)
+	if !supersede {
+		return refreshContext, cancel
+	}
+
+	refreshMutex.Lock()
+	defer refreshMutex.Unlock()
+	if refreshCancel != nil {
+		refreshCancel()
+	}
+	refreshCancel = cancel
+	return refreshContext, cancel
+}
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+func newFakeClientset(namespaces ...string) *fake.Clientset {
+	objects := []runtime.Object{}
+	for _, ns := range namespaces {
+		objects = append(objects, &v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: ns}})
+	}
+	return fake.NewSimpleClientset(objects...)
+}
+
+// TestConcurrentRefreshes runs full, per-context and current-context updates at once, run with -race
+func TestConcurrentRefreshes(t *testing.T) {
+	clientsets := map[string]kubernetes.Interface{}
+	for i := 0; i < 4; i++ {
+		clientsets[fmt.Sprintf("cluster-%d", i)] = newFakeClientset("default", "kube-system", fmt.Sprintf("team-%d", i))
+	}
+	setupTestClusters(t, clientsets)
+	root, menu := newTestRoot(t)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(4)
+		go func() {
+			defer wg.Done()
+			root.UpdateData()
+		}()
+		go func(i int) {
+			defer wg.Done()
+			root.UpdateContextData(fmt.Sprintf("cluster-%d", i%4))
+		}(i)
+		go func() {
+			defer wg.Done()
+			root.MarkCurrentContext()
+			UpdateTrayStatus()
+		}()
+		go func() {
+			defer wg.Done()
+			GetContextStatuses()
+		}()
+	}
+	wg.Wait()
+	// Superseded refreshes may leave the tree partially updated until the next full refresh
+	root.UpdateData()
+
+	for i := 0; i < 4; i++ {
+		ctx := fmt.Sprintf("cluster-%d", i)
+		for _, ns := range []string{"default", "kube-system", fmt.Sprintf("team-%d", i)} {
+			assertVisible(t, menu.Find(ctx, ns), true, ctx, ns)
+		}
+		namespaces := 0
+		for _, nsElement := range root.Children[ctx].Children {
+			if !nsElement.Locked {
+				namespaces++
+			}
+		}
+		if namespaces != 3 {
+			t.Errorf("%s has %d namespaces, want 3", ctx, namespaces)
+		}
+	}
+	if !menu.Find("cluster-0").Checked() {
+		t.Error("current context is not checked")
+	}
+}
+
+// TestSupersededRefreshKeepsKubeconfigs lets a refresh superseded while listing namespaces finish after the newer
+// one, its outdated namespaces must neither be shown nor written, run with -race
+func TestSupersededRefreshKeepsKubeconfigs(t *testing.T) {
+	listedCh := make(chan struct{})
+	releaseCh := make(chan struct{})
+	stale := newFakeClientset("stale")
+	stale.PrependReactor("list", "namespaces", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		close(listedCh)
+		<-releaseCh
+		return false, nil, nil
+	})
+	setupTestClusters(t, map[string]kubernetes.Interface{"kind-kind": stale})
+	root, menu := newTestRoot(t)
+
+	doneCh := make(chan struct{})
+	go func() {
+		defer close(doneCh)
+		root.UpdateData()
+	}()
+	<-listedCh
+	NewClientset = func(config *clientcmdapi.Config) (kubernetes.Interface, error) {
+		return newFakeClientset("fresh"), nil
+	}
+	root.UpdateData()
+	close(releaseCh)
+	<-doneCh
+
+	if namespaces := ReadContextNamespaces("kind-kind"); !reflect.DeepEqual(namespaces, []string{"fresh"}) {
+		t.Errorf("kubeconfigs written for %v, want fresh only", namespaces)
+	}
+	assertVisible(t, menu.Find("kind-kind", "fresh"), true, "kind-kind", "fresh")
+	if _, ok := root.Children["kind-kind"].Children["stale"]; ok {
+		t.Error("superseded refresh added its namespace")
+	}
+}
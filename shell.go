@@ -1,23 +1,136 @@
 package main
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
-
-	"github.com/spf13/viper"
+	"strings"
+	"text/template"
 )
 
-func OpenTerminal(ctx string, ns string) {
-	shellCommand := viper.GetStringSlice("shell.command")
-	kubeconfigPath := filepath.Join(contextDirectory, ctx, ns)
-	cmd := exec.Command(shellCommand[0], shellCommand[1:]...)
+// TerminalData is available as placeholders in launch command templates, e.g. {{.Context}}
+type TerminalData struct {
+	Context    string
+	Namespace  string
+	Cluster    string
+	Server     string
+	User       string
+	Kubeconfig string
+	// Only set for pod level commands
+	Pod       string
+	Container string
+	Shell     string
+	File      string
+}
+
+func NewTerminalData(ctx string, ns string) TerminalData {
+	data := TerminalData{
+		Context:    ctx,
+		Namespace:  ns,
+		Kubeconfig: filepath.Join(contextDirectory, ctx, ns),
+	}
+	if config, ok := GetContextKubeconfig(ctx); ok {
+		context := config.Contexts[ctx]
+		data.Cluster = context.Cluster
+		data.User = context.AuthInfo
+		if cluster, ok := config.Clusters[context.Cluster]; ok {
+			data.Server = cluster.Server
+		}
+	}
+	return data
+}
+
+func OpenTerminal(ctx string, ns string) error {
+	settings := GetContextSettings(ctx)
+	data := NewTerminalData(ctx, ns)
+	return StartCommand(fmt.Sprintf("Shell for %s | %s", ctx, ns), GetShellCommand(settings), data, settings)
+}
+
+// GetShellCommand returns the terminal command of the context settings, `shell.command` unless overridden
+func GetShellCommand(settings ContextConfig) []string {
+	if command := settings.Command; len(command) > 0 {
+		return command
+	}
+	return GetConfig().Shell.Command
+}
+
+// StartCommand renders the argv templates and starts the command detached
+func StartCommand(description string, command []string, data TerminalData, settings ContextConfig) error {
+	cmd, err := NewCommand(command, data, settings)
+	if err != nil {
+		return err
+	}
+	var out bytes.Buffer
+	cmd.Stdout = &out
+	cmd.Stderr = &out
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	// Terminals live independently of the tray, collect their result in background
+	go func() {
+		err := cmd.Wait()
+		if err != nil {
+			trayLog.Warningf("%s exited with %s: %s", description, err, out.String())
+			return
+		}
+		trayLog.Debugf("Exec output: %s", out.String())
+	}()
+	return nil
+}
+
+// NewCommand renders the argv templates with KUBECONFIG set, extra environment and
+// working directory are taken from the `env` and `dir` context settings
+func NewCommand(command []string, data TerminalData, settings ContextConfig) (*exec.Cmd, error) {
+	if len(command) == 0 {
+		return nil, errors.New("command is empty")
+	}
+	args, err := RenderTemplates(command, data)
+	if err != nil {
+		return nil, err
+	}
+	env, err := RenderTemplates(settings.Env, data)
+	if err != nil {
+		return nil, err
+	}
+	dir, err := RenderTemplate(settings.Dir, data)
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := exec.Command(args[0], args[1:]...)
+	cmd.Dir = dir
 	cmd.Env = os.Environ()
-	cmd.Env = append(cmd.Env, fmt.Sprintf("KUBECONFIG=%s", kubeconfigPath))
-	out, err := cmd.CombinedOutput()
+	cmd.Env = append(cmd.Env, fmt.Sprintf("KUBECONFIG=%s", data.Kubeconfig))
+	cmd.Env = append(cmd.Env, env...)
+	return cmd, nil
+}
+
+func RenderTemplates(texts []string, data interface{}) ([]string, error) {
+	rendered := []string{}
+	for _, text := range texts {
+		result, err := RenderTemplate(text, data)
+		if err != nil {
+			return nil, err
+		}
+		rendered = append(rendered, result)
+	}
+	return rendered, nil
+}
+
+func RenderTemplate(text string, data interface{}) (string, error) {
+	if !strings.Contains(text, "{{") {
+		return text, nil
+	}
+	tmpl, err := template.New("command").Option("missingkey=error").Parse(text)
 	if err != nil {
-		trayLog.Fatalf("cmd.Run() failed with %s\n", err)
+		return "", err
+	}
+	var result bytes.Buffer
+	if err := tmpl.Execute(&result, data); err != nil {
+		return "", err
 	}
-	trayLog.Debugf("Exec output: %s", out)
+	return result.String(), nil
 }
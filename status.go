@@ -0,0 +1,113 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/solacens/kube-tray/icon"
+)
+
+const defaultTrayTitle = "Kubernetes Tray"
+
+var (
+	statusMutex      sync.Mutex
+	runningRefreshes int
+	healthSeverity   = map[HealthStatus]int{
+		HealthUnknown:      0,
+		HealthHealthy:      1,
+		HealthDegraded:     2,
+		HealthUnauthorized: 3,
+		HealthUnreachable:  3,
+	}
+)
+
+func startRefreshStatus() {
+	statusMutex.Lock()
+	runningRefreshes++
+	statusMutex.Unlock()
+	UpdateTrayStatus()
+}
+
+func finishRefreshStatus() {
+	statusMutex.Lock()
+	runningRefreshes--
+	statusMutex.Unlock()
+	UpdateTrayStatus()
+}
+
+// CountsTowardsStatus tells whether the context is part of the aggregate status, configured by
+// `status-icon.contexts` and `status-icon.exclude` as names or glob patterns
+func CountsTowardsStatus(ctx string) bool {
+	for _, pattern := range GetConfig().StatusIcon.Exclude {
+		if MatchContext(pattern, ctx) {
+			return false
+		}
+	}
+	patterns := GetConfig().StatusIcon.Contexts
+	if len(patterns) == 0 {
+		return true
+	}
+	for _, pattern := range patterns {
+		if MatchContext(pattern, ctx) {
+			return true
+		}
+	}
+	return false
+}
+
+// UpdateTrayStatus swaps the tray icon and tooltip for the worst health of the counted contexts
+func UpdateTrayStatus() {
+	if rootElement == nil || rootElement.Menu == nil {
+		return
+	}
+	statusMutex.Lock()
+	defer statusMutex.Unlock()
+
+	worst := HealthUnknown
+	byStatus := map[HealthStatus][]string{}
+	for _, ctx := range GetKubeconfigContexts() {
+		if !CountsTowardsStatus(ctx) {
+			continue
+		}
+		health := GetContextHealth(ctx)
+		if health.Status == HealthUnknown {
+			continue
+		}
+		byStatus[health.Status] = append(byStatus[health.Status], ctx)
+		if healthSeverity[health.Status] > healthSeverity[worst] {
+			worst = health.Status
+		}
+	}
+
+	summary := []string{}
+	for _, status := range []HealthStatus{HealthUnreachable, HealthUnauthorized, HealthDegraded} {
+		if ctxs := byStatus[status]; len(ctxs) > 0 {
+			sort.Strings(ctxs)
+			summary = append(summary, fmt.Sprintf("%s: %s", status, strings.Join(ctxs, ", ")))
+		}
+	}
+	if ctxs := byStatus[HealthHealthy]; len(ctxs) > 0 {
+		summary = append(summary, fmt.Sprintf("%d healthy", len(ctxs)))
+	}
+	tooltip := defaultTrayTitle
+	if len(summary) > 0 {
+		tooltip = fmt.Sprintf("%s\n%s", defaultTrayTitle, strings.Join(summary, "\n"))
+	}
+
+	switch {
+	case runningRefreshes > 0:
+		rootElement.Menu.SetIcon(icon.Refreshing)
+		tooltip = fmt.Sprintf("%s\nRefreshing...", tooltip)
+	case worst == HealthUnreachable || worst == HealthUnauthorized:
+		rootElement.Menu.SetIcon(icon.Error)
+	case worst == HealthDegraded:
+		rootElement.Menu.SetIcon(icon.Degraded)
+	case worst == HealthHealthy:
+		rootElement.Menu.SetIcon(icon.Healthy)
+	default:
+		rootElement.Menu.SetIcon(icon.Data)
+	}
+	rootElement.Menu.SetTooltip(tooltip)
+}
@@ -0,0 +1,166 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+const kubeconfigWatchDebounce = time.Second
+
+// WatchKubeconfig watches every file of the default loading rules and updates the tree on changes
+func WatchKubeconfig() {
+	paths := []string{}
+	for _, path := range clientcmd.NewDefaultClientConfigLoadingRules().Precedence {
+		if path != "" {
+			paths = append(paths, path)
+		}
+	}
+	if _, err := watchKubeconfigFiles(paths, kubeconfigWatchDebounce, onKubeconfigChanged); err != nil {
+		kubeLog.Warning(err)
+	}
+}
+
+// kubeconfigWatch watches the kubeconfig files through their directories, a directory missing at startup, e.g.
+// ~/.kube before the first cluster is created, is watched through its nearest existing ancestor until it appears
+type kubeconfigWatch struct {
+	watcher  *fsnotify.Watcher
+	files    map[string]bool
+	onChange func()
+	debounce time.Duration
+	timer    *time.Timer
+	// Directory of kubeconfig files to the directory actually watched for it, empty when unwatched
+	directories map[string]string
+	ancestors   map[string]bool
+}
+
+// watchKubeconfigFiles calls onChange once the files settled after changes, it returns the watcher to close
+func watchKubeconfigFiles(paths []string, debounce time.Duration, onChange func()) (*fsnotify.Watcher, error) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+	watch := &kubeconfigWatch{
+		watcher:     watcher,
+		files:       map[string]bool{},
+		onChange:    onChange,
+		debounce:    debounce,
+		directories: map[string]string{},
+		ancestors:   map[string]bool{},
+	}
+	// Watch the parent directories as editors and tools usually replace the file instead of writing it
+	for _, path := range paths {
+		path = filepath.Clean(path)
+		watch.files[path] = true
+		watch.directories[filepath.Dir(path)] = ""
+	}
+	watch.watchDirectories(false)
+	go watch.run()
+	return watcher, nil
+}
+
+func (watch *kubeconfigWatch) run() {
+	for {
+		select {
+		case event, ok := <-watch.watcher.Events:
+			if !ok {
+				return
+			}
+			name := filepath.Clean(event.Name)
+			gone := false
+			if watched, ok := watch.directories[name]; ok && watched == name && event.Op&(fsnotify.Remove|fsnotify.Rename) != 0 {
+				// The directory is gone, fall back to an ancestor until it is created again
+				watch.watcher.Remove(name)
+				watch.directories[name] = ""
+				gone = true
+			}
+			if gone || len(watch.ancestors) > 0 {
+				watch.watchDirectories(true)
+			}
+			if watch.files[name] {
+				watch.changed()
+			}
+		case err, ok := <-watch.watcher.Errors:
+			if !ok {
+				return
+			}
+			kubeLog.Warning(err)
+		}
+	}
+}
+
+// watchDirectories watches each directory or its nearest existing ancestor, files already present in a newly
+// created directory count as a change as their creation may have been missed
+func (watch *kubeconfigWatch) watchDirectories(created bool) {
+	ancestors := map[string]bool{}
+	for directory, watched := range watch.directories {
+		if watched == directory {
+			continue
+		}
+		target := directory
+		for {
+			if info, err := os.Stat(target); err == nil && info.IsDir() {
+				break
+			}
+			parent := filepath.Dir(target)
+			if parent == target {
+				break
+			}
+			target = parent
+		}
+		if target == watched {
+			ancestors[target] = true
+			continue
+		}
+		if err := watch.watcher.Add(target); err != nil {
+			kubeLog.Warningf("Unable to watch %s: %s", directory, err)
+			continue
+		}
+		watch.directories[directory] = target
+		if target != directory {
+			kubeLog.Infof("Watching [%s] until [%s] is created", target, directory)
+			ancestors[target] = true
+			continue
+		}
+		for path := range watch.files {
+			if filepath.Dir(path) != directory {
+				continue
+			}
+			kubeLog.Infof("Watching kubeconfig [%s]", path)
+			if _, err := os.Stat(path); err == nil && created {
+				watch.changed()
+			}
+		}
+	}
+	for ancestor := range watch.ancestors {
+		if _, ok := watch.directories[ancestor]; !ok && !ancestors[ancestor] {
+			watch.watcher.Remove(ancestor)
+		}
+	}
+	watch.ancestors = ancestors
+}
+
+func (watch *kubeconfigWatch) changed() {
+	if watch.timer != nil {
+		watch.timer.Stop()
+	}
+	watch.timer = time.AfterFunc(watch.debounce, watch.onChange)
+}
+
+func onKubeconfigChanged() {
+	kubeLog.Info("Kubeconfig changed on disk")
+	changed, removed := ReloadChangedKubeconfig()
+	for _, ctx := range removed {
+		rootElement.DisposeContext(ctx)
+	}
+	rootElement.SyncInvalidContexts()
+	rootElement.MarkCurrentContext()
+	UpdateTrayStatus()
+	UpdateEventWatches()
+	if len(changed) > 0 {
+		rootElement.UpdateContextsData(changed)
+	}
+}
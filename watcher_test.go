@@ -0,0 +1,79 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func watchTestKubeconfig(t *testing.T, path string) chan struct{} {
+	t.Helper()
+	changedCh := make(chan struct{}, 10)
+	watcher, err := watchKubeconfigFiles([]string{path}, 10*time.Millisecond, func() {
+		changedCh <- struct{}{}
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		watcher.Close()
+	})
+	return changedCh
+}
+
+func waitKubeconfigChanged(t *testing.T, changedCh chan struct{}) {
+	t.Helper()
+	select {
+	case <-changedCh:
+	case <-time.After(5 * time.Second):
+		t.Fatal("kubeconfig change not noticed")
+	}
+	// Drain the notifications of the same change
+	time.Sleep(50 * time.Millisecond)
+	for len(changedCh) > 0 {
+		<-changedCh
+	}
+}
+
+func writeWatchedKubeconfig(t *testing.T, path string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte("apiVersion: v1\nkind: Config\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestWatchKubeconfigChange(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".kube", "config")
+	writeWatchedKubeconfig(t, path)
+	changedCh := watchTestKubeconfig(t, path)
+
+	if err := os.WriteFile(filepath.Join(filepath.Dir(path), "other"), nil, 0600); err != nil {
+		t.Fatal(err)
+	}
+	writeWatchedKubeconfig(t, path)
+	waitKubeconfigChanged(t, changedCh)
+}
+
+// TestWatchKubeconfigMissingDirectory creates the kubeconfig directory after the watch started, like the first
+// kind create cluster, and then removes and recreates it
+func TestWatchKubeconfigMissingDirectory(t *testing.T) {
+	home := t.TempDir()
+	path := filepath.Join(home, ".kube", "nested", "config")
+	changedCh := watchTestKubeconfig(t, path)
+
+	writeWatchedKubeconfig(t, path)
+	waitKubeconfigChanged(t, changedCh)
+	writeWatchedKubeconfig(t, path)
+	waitKubeconfigChanged(t, changedCh)
+
+	if err := os.RemoveAll(filepath.Join(home, ".kube")); err != nil {
+		t.Fatal(err)
+	}
+	waitKubeconfigChanged(t, changedCh)
+	writeWatchedKubeconfig(t, path)
+	waitKubeconfigChanged(t, changedCh)
+}
@@ -0,0 +1,183 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/utils/pointer"
+)
+
+const (
+	WorkloadDeployment  = "Deployment"
+	WorkloadStatefulSet = "StatefulSet"
+	WorkloadDaemonSet   = "DaemonSet"
+
+	workloadsTitle     = "Workloads"
+	loadWorkloadsTitle = "Load workloads"
+)
+
+// Workload is a Deployment, StatefulSet or DaemonSet listed in the Workloads submenu
+type Workload struct {
+	Kind    string
+	Name    string
+	Ready   int32
+	Desired int32
+}
+
+var (
+	visitedMutex      sync.Mutex
+	visitedNamespaces = map[string]map[string]bool{}
+)
+
+func (workload Workload) Key() string {
+	return fmt.Sprintf("%s/%s", workload.Kind, workload.Name)
+}
+
+// VisitNamespace marks the workloads of the namespace to be listed on every refresh
+func VisitNamespace(ctx string, ns string) {
+	visitedMutex.Lock()
+	defer visitedMutex.Unlock()
+	if _, ok := visitedNamespaces[ctx]; !ok {
+		visitedNamespaces[ctx] = map[string]bool{}
+	}
+	visitedNamespaces[ctx][ns] = true
+}
+
+func GetVisitedNamespaces(ctx string) []string {
+	visitedMutex.Lock()
+	defer visitedMutex.Unlock()
+	namespaces := []string{}
+	for ns := range visitedNamespaces[ctx] {
+		namespaces = append(namespaces, ns)
+	}
+	return namespaces
+}
+
+// GetWorkloads lists the Deployments, StatefulSets and DaemonSets of the namespace
+func GetWorkloads(reqContext context.Context, clientset kubernetes.Interface, ns string) ([]Workload, error) {
+	workloads := []Workload{}
+	deployments, err := clientset.AppsV1().Deployments(ns).List(reqContext, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, deployment := range deployments.Items {
+		workloads = append(workloads, Workload{
+			Kind:    WorkloadDeployment,
+			Name:    deployment.Name,
+			Ready:   deployment.Status.ReadyReplicas,
+			Desired: pointer.Int32Deref(deployment.Spec.Replicas, 1),
+		})
+	}
+	statefulSets, err := clientset.AppsV1().StatefulSets(ns).List(reqContext, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, statefulSet := range statefulSets.Items {
+		workloads = append(workloads, Workload{
+			Kind:    WorkloadStatefulSet,
+			Name:    statefulSet.Name,
+			Ready:   statefulSet.Status.ReadyReplicas,
+			Desired: pointer.Int32Deref(statefulSet.Spec.Replicas, 1),
+		})
+	}
+	daemonSets, err := clientset.AppsV1().DaemonSets(ns).List(reqContext, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, daemonSet := range daemonSets.Items {
+		workloads = append(workloads, Workload{
+			Kind:    WorkloadDaemonSet,
+			Name:    daemonSet.Name,
+			Ready:   daemonSet.Status.NumberReady,
+			Desired: daemonSet.Status.DesiredNumberScheduled,
+		})
+	}
+	sort.Slice(workloads, func(i, j int) bool {
+		return workloads[i].Key() < workloads[j].Key()
+	})
+	return workloads, nil
+}
+
+// GetVisitedWorkloads lists the workloads of the namespaces visited so far, failed namespaces are left out
+func GetVisitedWorkloads(reqContext context.Context, clientset kubernetes.Interface, ctx string) map[string][]Workload {
+	workloads := map[string][]Workload{}
+	for _, ns := range GetVisitedNamespaces(ctx) {
+		nsWorkloads, err := GetWorkloads(reqContext, clientset, ns)
+		if err != nil {
+			kubeLog.Warningf("Unable to list workloads of [%s | %s]: %s", ctx, ns, err)
+			continue
+		}
+		workloads[ns] = nsWorkloads
+	}
+	return workloads
+}
+
+// UpdateWorkloadsData lists the workloads of a namespace on demand
+func (rootElement *Element) UpdateWorkloadsData(ctx string, ns string) {
+	VisitNamespace(ctx, ns)
+	config, ok := GetContextKubeconfig(ctx)
+	if !ok {
+		return
+	}
+	clientset, err := NewClientset(config)
+	if err != nil {
+		ShowLastError(err)
+		return
+	}
+	reqContext, cancel := context.WithTimeout(context.Background(), GetConfig().AutoRefresh.Timeout.Duration())
+	defer cancel()
+	workloads, err := GetWorkloads(reqContext, clientset, ns)
+	if err != nil {
+		ShowLastError(fmt.Errorf("unable to list workloads of %s | %s: %w", ctx, ns, err))
+		return
+	}
+
+	rootElement.mutex.Lock()
+	defer rootElement.mutex.Unlock()
+	if ctxElement, ok := rootElement.Children[ctx]; ok {
+		if nsElement, ok := ctxElement.Children[ns]; ok {
+			nsElement.SetWorkloads(ctx, workloads)
+		}
+	}
+}
+
+// AddWorkloadsMenu adds the Workloads submenu, its content is loaded when first requested
+func (nsElement *Element) AddWorkloadsMenu(ctx string, ns string) {
+	workloadsElement := nsElement.AddChild(workloadsTitle, true)
+	workloadsElement.AddChild(loadWorkloadsTitle, true).ChannelWaitForClick(func() {
+		trayLog.Infof("Load workloads for %s | %s", ctx, ns)
+		rootElement.UpdateWorkloadsData(ctx, ns)
+	})
+}
+
+// SetWorkloads replaces the entries of the Workloads submenu
+func (nsElement *Element) SetWorkloads(ctx string, workloads []Workload) {
+	workloadsElement, ok := nsElement.Children[workloadsTitle]
+	if !ok {
+		return
+	}
+	workloadsElement.Children[loadWorkloadsTitle].MenuItem.SetTitle("Reload workloads")
+	workloadsElement.ElementTraversalMarkNonUpdated()
+	for _, workload := range workloads {
+		workloadElement := workloadsElement.UpsertWorkload(ctx, nsElement.Title, workload)
+		workloadElement.MenuItem.SetTitle(fmt.Sprintf("%s %d/%d", workload.Key(), workload.Ready, workload.Desired))
+	}
+	workloadsElement.ElementTraversalDisposeNonUpdated()
+}
+
+func (workloadsElement *Element) UpsertWorkload(ctx string, ns string, workload Workload) *Element {
+	if existingElement, ok := workloadsElement.Children[workload.Key()]; ok {
+		existingElement.Updated = true
+		return existingElement
+	}
+	if disposedElement, ok := workloadsElement.Revive(workload.Key()); ok {
+		return disposedElement
+	}
+	workloadElement := workloadsElement.AddChild(workload.Key(), false)
+	workloadElement.AddWorkloadActions(ctx, ns, workload)
+	return workloadElement
+}